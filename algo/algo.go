@@ -17,6 +17,61 @@ import (
 	"golang.org/x/sync/semaphore"
 )
 
+// Optional hooks to follow the progress of a batch while it is processed
+type BatchListener struct {
+	OnTranslationResponse func(response *TranslationResponse)
+	OnEdgeStatusChange    func(edge *ResponseTranslationEdge)
+}
+
+func (listener *BatchListener) translationResponse(response *TranslationResponse) {
+	if listener != nil && listener.OnTranslationResponse != nil {
+		listener.OnTranslationResponse(response)
+	}
+}
+
+// Reports every status change of the edges in the tree. Edges are shared between paths, so each one is attached once
+func (listener *BatchListener) attachToEdges(allPaths []Path) {
+	if listener == nil || listener.OnEdgeStatusChange == nil {
+		return
+	}
+
+	for _, path := range allPaths {
+		for _, edge := range path.Edges {
+			listener.attachToEdge(edge)
+		}
+	}
+}
+
+// The edge is converted right away by the goroutine that changed its status, which is the only one writing it at
+// that time, so the listener gets a snapshot that it can keep
+func (listener *BatchListener) attachToEdge(edge *TranslationEdge) {
+	if listener == nil || listener.OnEdgeStatusChange == nil || edge.OnStatusChange != nil {
+		return
+	}
+
+	edge.OnStatusChange = func(edge *TranslationEdge) {
+		listener.OnEdgeStatusChange(ConvertToEdgeResponse(edge))
+	}
+}
+
+// Gathers the responses of a batch as they finish, notifying the listener of each of them
+func collectResponses(responseChannel chan *TranslationResponse, listener *BatchListener) chan []*TranslationResponse {
+	collected := make(chan []*TranslationResponse, 1)
+
+	go func() {
+		allResponses := []*TranslationResponse{}
+
+		for response := range responseChannel {
+			listener.translationResponse(response)
+			allResponses = append(allResponses, response)
+		}
+
+		collected <- allResponses
+	}()
+
+	return collected
+}
+
 type TraversalUnit struct {
 	TranslationEdge         TranslationEdge
 	ExecutorQueueSingleton  ExecutorQueueSingleton
@@ -24,51 +79,41 @@ type TraversalUnit struct {
 	IsDone                  bool
 }
 
+// The progress bar advances once per translation path, so this counts the paths of the ToCT of every request
 func GetTotalEdgesCountIntermediates(batchRequest *BatchTranslationRequest) int {
 	total := 0
-	var totalEdgesForRequest int
 
-	//TODO: This is hardcoded
 	for _, request := range batchRequest.TranslationRequests {
-		if ConfigStore.ExpansionDepth == 1 {
-			totalEdgesForRequest = 1
-		} else if ConfigStore.ExpansionDepth == 3 {
-			totalEdgesForRequest = 21
-		} else if ConfigStore.ExpansionDepth == 4 {
-			totalEdgesForRequest = 85
-		} else {
-			panic("Count for this depth not implemented")
-		}
-
-		// lenLanguagesUsed := len(request.UsedLanguages)
-
-		// for l := 1; l < common.ConfigStore.ExpansionDepth; l++ {
-		// 	floatLevel := float64(l - 1)
-		// 	langsAdj := float64(lenLanguagesUsed - 2)
-		// 	totalEdgesForRequest = totalEdgesForRequest + int(math.Pow(langsAdj, floatLevel))*l
-		// }
-
-		_ = request
-		total = total + totalEdgesForRequest
+		config := RequestConfig(request)
+		totalPaths, _ := CountIntermediatesTranslationTree(request.UsedLanguages, request.SeedLanguage, request.TargetLanguage, config.ExpansionDepth, config.GetCandidatesPerEdge())
+		total = total + totalPaths
 	}
 
 	return total
 }
 
-func ProccessVerificationRequest(request *VerificationRequest, wg *sync.WaitGroup, results chan *VerificationResponse, bar *uiprogress.Bar) {
+func ProccessVerificationRequest(ctx context.Context, request *VerificationRequest, wg *sync.WaitGroup, results chan *VerificationResponse, bar *uiprogress.Bar) {
 	defer wg.Done()
 	translationEdge := &TranslationEdge{}
 
-	if request.TestSuite == nil || len(request.TestSuite.UnitTestSuite) == 0 && len(request.TestSuite.FuzzySuite) == 0 {
-		panic("Please provide unit tests")
+	if err := ValidateVerificationRequest(request); err != nil {
+		results <- &VerificationResponse{
+			VerificationRequest: request,
+			Status:              FAILED.String(),
+			Error:               err.Error(),
+		}
+		bar.Incr()
+		return
 	}
 
 	//Fuzzy tests are language independent so we can evaluate all of them
 	for _, test := range request.TestSuite.FuzzySuite {
+		comparator := ResolveFuzzyComparator(request.TestSuite, test)
 
 		fuzzyTest := FuzzyTest{
 			Input:          test.StdinInput,
 			ExpectedOutput: test.ExpectedOutput,
+			Comparator:     comparator,
 		}
 
 		translationEdge.FuzzyTests = append(translationEdge.FuzzyTests, fuzzyTest)
@@ -84,10 +129,6 @@ func ProccessVerificationRequest(request *VerificationRequest, wg *sync.WaitGrou
 		translationEdge.UnitTests = append(translationEdge.UnitTests, unitTest)
 	}
 
-	if len(request.TestSuite.UnitTestSuite) > 0 && len(request.TestSuite.FuzzySuite) > 0 {
-		panic("We don't yet support evaluation with mixed fuzzy and unit tests. Feel free to contribute to this :=)")
-	}
-
 	translationEdge.InferenceOutput = request.InferenceOutput
 	translationEdge.TargetLanguage = request.TargetLanguage
 	translationEdge.InputLanguage = request.SourceLanguage
@@ -95,13 +136,8 @@ func ProccessVerificationRequest(request *VerificationRequest, wg *sync.WaitGrou
 	translationEdge.StatusMutex = &sync.Mutex{}
 	translationEdge.SetStatus(PENDING)
 
-	//Extract the source code
-	if !common.ConfigStore.ApplyRegexInferenceOnly {
-		panic("We only support extracting from inference output at this time. Please set ApplyRegexInferenceOnly to true")
-	}
-
-	//FIXME: This is hardcoded
-	translationEdge.RegexTemplate = GetRegexTemplate("temperature")
+	//Extract the source code. Only extracting from the inference output is supported, see CheckVerificationConfig
+	translationEdge.RegexTemplate = GetRegexTemplate(verificationRegexTemplate)
 	extracted, extractedOk := ExtractSourceCode("", translationEdge.RegexTemplate, request.InferenceOutput)
 
 	//Can't process downstream edges as we weren't able to extract the code
@@ -109,7 +145,7 @@ func ProccessVerificationRequest(request *VerificationRequest, wg *sync.WaitGrou
 		translationEdge.SetStatus(FAILED_NO_EXTRACTED)
 	} else {
 		translationEdge.ExtractedSourceCode = extracted
-		PerformEdgeExecution(translationEdge, translationEdge.TargetLanguage)
+		PerformEdgeExecution(ctx, translationEdge, translationEdge.TargetLanguage)
 	}
 
 	responseFuzzyTests := []*ResponseFuzzyTestCase{}
@@ -124,17 +160,18 @@ func ProccessVerificationRequest(request *VerificationRequest, wg *sync.WaitGrou
 	}
 
 	verificationResponse := &VerificationResponse{
-		FuzzyTests:          responseFuzzyTests,
-		UnitTests:           responseUnitTests,
-		VerificationRequest: request,
-		Status:              translationEdge.GetStatus().String(),
+		FuzzyTests:           responseFuzzyTests,
+		UnitTests:            responseUnitTests,
+		VerificationRequest:  request,
+		Status:               translationEdge.GetStatus().String(),
+		FailedTestCategories: translationEdge.FailedTestCategories(),
 	}
 
 	results <- verificationResponse
 	bar.Incr()
 }
 
-func BatchRunVerification(batchRequest *BatchVerificationRequest) *BatchVerificationResponse {
+func BatchRunVerification(ctx context.Context, batchRequest *BatchVerificationRequest) *BatchVerificationResponse {
 
 	bar := uiprogress.AddBar(len(batchRequest.VerificationRequests)).AppendCompleted().AppendElapsed()
 	bar.PrependFunc(func(b *uiprogress.Bar) string {
@@ -146,7 +183,7 @@ func BatchRunVerification(batchRequest *BatchVerificationRequest) *BatchVerifica
 
 	for _, request := range batchRequest.VerificationRequests {
 		wg.Add(1)
-		go ProccessVerificationRequest(request, &wg, chanResponses, bar)
+		go ProccessVerificationRequest(ctx, request, &wg, chanResponses, bar)
 	}
 
 	wg.Wait()
@@ -164,7 +201,7 @@ func BatchRunVerification(batchRequest *BatchVerificationRequest) *BatchVerifica
 
 }
 
-func DirectCAK(batchRequest *BatchTranslationRequest) *BatchTranslationResponse {
+func DirectCAK(ctx context.Context, batchRequest *BatchTranslationRequest) *BatchTranslationResponse {
 	var wtg sync.WaitGroup
 
 	//We assign an id to the request
@@ -173,6 +210,8 @@ func DirectCAK(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 	shortUUID := uuidObj.String()[:6]
 	batchRequest.Id = shortUUID
 
+	ApplyBatchOverrides(batchRequest)
+
 	//Keep references to preserve the order when we return the responses
 	responseChannel := make(chan *TranslationResponse, len(batchRequest.TranslationRequests))
 
@@ -184,22 +223,35 @@ func DirectCAK(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 		return fmt.Sprintf("%s (%d/%d)", shortUUID, b.Current(), totalEdges)
 	})
 
-	semCtx := context.TODO()
-
 	//TODO: This batch size is hardcoded
 	var (
 		maxBatch = 250
 		sem      = semaphore.NewWeighted(int64(maxBatch))
 	)
 
-	for _, request := range batchRequest.TranslationRequests {
-		wtg.Add(1)
+	//Shared by the requests, which also have their own budgets
+	batchBudget := NewBudgetTracker(batchRequest.Budget, nil)
+
+	for index, request := range batchRequest.TranslationRequests {
+		//Stop scheduling new requests once the batch is cancelled
+		if err := sem.Acquire(ctx, 1); err != nil {
+			cancelUnscheduledRequests(batchRequest.TranslationRequests[index:], err, responseChannel)
+			break
+		}
 
-		if err := sem.Acquire(semCtx, 1); err != nil {
-			panic("Failed to acquire semaphore")
+		//Invalid requests are answered right away so that the rest of the batch still runs
+		if err := ValidateDirectCAKRequest(request); err != nil {
+			sem.Release(1)
+			responseChannel <- invalidTranslationResponse(request, err)
+			//FIXME: This should not be hardcoded
+			for i := 0; i < 10; i++ {
+				bar.Incr()
+			}
+			continue
 		}
 
-		go RequestTranslationDirectorCAK(request, &wtg, bar, responseChannel, sem)
+		wtg.Add(1)
+		go RequestTranslationDirectorCAK(ctx, request, &wtg, bar, responseChannel, sem, batchBudget)
 
 	}
 
@@ -222,6 +274,8 @@ func DirectCAK(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 	response := &BatchTranslationResponse{
 		RequestId:            shortUUID,
 		TranslationResponses: allResponses,
+		Usage:                SumBatchTokenUsage(allResponses),
+		BudgetUsage:          batchBudget.ToResponse(),
 	}
 
 	if batchRequest.FileBaseName != "" && batchRequest.FileSavePath != "" {
@@ -231,22 +285,28 @@ func DirectCAK(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 			RequestId:            shortUUID,
 			TranslationResponses: []*TranslationResponse{},
 			ReturnedToDisk:       true,
+			Usage:                response.Usage,
+			BudgetUsage:          response.BudgetUsage,
 		}
 	}
 	return response
 }
 
-func InterTrans(batchRequest *BatchTranslationRequest) *BatchTranslationResponse {
+func InterTrans(ctx context.Context, batchRequest *BatchTranslationRequest, listener *BatchListener) *BatchTranslationResponse {
 	var wtg sync.WaitGroup
 
-	//We assign an id to the request
-	//FIXME: Should be done on the client side and returned immediately for the client to see
-	uuidObj := uuid.New()
-	shortUUID := uuidObj.String()[:6]
-	batchRequest.Id = shortUUID
+	//We assign an id to the request, unless it was already assigned when submitting it as a job
+	if batchRequest.Id == "" {
+		batchRequest.Id = NewBatchId()
+	}
+	shortUUID := batchRequest.Id
+
+	//The overrides of the batch are copied to each request before counting the edges
+	ApplyBatchOverrides(batchRequest)
 
 	//Keep references to preserve the order when we return the responses
 	responseChannel := make(chan *TranslationResponse, len(batchRequest.TranslationRequests))
+	collectedResponses := collectResponses(responseChannel, listener)
 
 	//Initialize progress bar
 	totalEdges := GetTotalEdgesCountIntermediates(batchRequest)
@@ -256,22 +316,37 @@ func InterTrans(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 		return fmt.Sprintf("%s (%d/%d)", shortUUID, b.Current(), totalEdges)
 	})
 
-	semCtx := context.TODO()
-
 	//FIXME: This batch size is hardcoded
 	var (
 		maxBatch = 250
 		sem      = semaphore.NewWeighted(int64(maxBatch))
 	)
 
-	for _, request := range batchRequest.TranslationRequests {
-		wtg.Add(1)
+	//Shared by the requests, which also have their own budgets
+	batchBudget := NewBudgetTracker(batchRequest.Budget, nil)
 
-		if err := sem.Acquire(semCtx, 1); err != nil {
-			panic("Failed to acquire semaphore")
+	for index, request := range batchRequest.TranslationRequests {
+		//Stop scheduling new requests once the batch is cancelled
+		if err := sem.Acquire(ctx, 1); err != nil {
+			cancelUnscheduledRequests(batchRequest.TranslationRequests[index:], err, responseChannel)
+			break
 		}
 
-		go RequestTranslationDirector(request, &wtg, bar, responseChannel, sem)
+		//Invalid requests are answered right away so that the rest of the batch still runs
+		if err := ValidateInterTransRequest(request); err != nil {
+			sem.Release(1)
+			responseChannel <- invalidTranslationResponse(request, err)
+
+			config := RequestConfig(request)
+			totalPaths, _ := CountIntermediatesTranslationTree(request.UsedLanguages, request.SeedLanguage, request.TargetLanguage, config.ExpansionDepth, config.GetCandidatesPerEdge())
+			for i := 0; i < totalPaths; i++ {
+				bar.Incr()
+			}
+			continue
+		}
+
+		wtg.Add(1)
+		go RequestTranslationDirector(ctx, request, &wtg, bar, responseChannel, sem, listener, batchBudget)
 
 	}
 
@@ -280,11 +355,7 @@ func InterTrans(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 	//We are not expecting more values
 	close(responseChannel)
 
-	allResponses := []*TranslationResponse{}
-
-	for response := range responseChannel {
-		allResponses = append(allResponses, response)
-	}
+	allResponses := <-collectedResponses
 
 	//Return sorted by translation id
 	sort.SliceStable(allResponses, func(i, j int) bool {
@@ -294,6 +365,8 @@ func InterTrans(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 	response := &BatchTranslationResponse{
 		RequestId:            shortUUID,
 		TranslationResponses: allResponses,
+		Usage:                SumBatchTokenUsage(allResponses),
+		BudgetUsage:          batchBudget.ToResponse(),
 	}
 
 	if batchRequest.FileBaseName != "" && batchRequest.FileSavePath != "" {
@@ -303,92 +376,115 @@ func InterTrans(batchRequest *BatchTranslationRequest) *BatchTranslationResponse
 			RequestId:            shortUUID,
 			TranslationResponses: []*TranslationResponse{},
 			ReturnedToDisk:       true,
+			Usage:                response.Usage,
+			BudgetUsage:          response.BudgetUsage,
 		}
 	}
 	return response
 }
 
-func FindFailureReason(edge *TranslationEdge) (bool, bool) {
-	isCompilationRuntimeError := false
-	isTestError := false
+// Status of an edge whose execution failed. Scripts that don't report their phases only tell that it failed
+func ExecutionFailureStatus(phases ExecutionPhases) Status {
+	switch {
+	case phases.TimedOut:
+		return FAILED_EXECUTION_TIMEOUT
+	case phases.CompileFailed:
+		return FAILED_COMPILATION
+	case phases.Started:
+		return FAILED_RUNTIME
+	default:
+		return FAILED_EXECUTION
+	}
+}
 
-	if edge.FuzzyTests != nil {
-		//Find out the reason for the failure
-		for _, test := range edge.FuzzyTests {
+// Finds out why the first failing test failed. Fuzzy tests are checked before unit tests when a suite has both
+func FindFailureReason(edge *TranslationEdge) (bool, bool) {
+	for _, test := range edge.FuzzyTests {
 
-			if !test.ExitCodeZero {
-				isCompilationRuntimeError = true
-				break
-			}
+		if !test.ExitCodeZero {
+			return true, false
+		}
 
-			if !test.Passed {
-				isTestError = true
-				break
-			}
+		if !test.Passed {
+			return false, true
 		}
-	} else {
-		for _, test := range edge.UnitTests {
+	}
 
-			if !test.ExitCodeZero {
-				isCompilationRuntimeError = true
-				break
-			}
+	for _, test := range edge.UnitTests {
 
-			if !test.Passed {
-				isTestError = true
-				break
-			}
+		if !test.ExitCodeZero {
+			return true, false
+		}
+
+		if !test.Passed {
+			return false, true
 		}
 	}
 
-	return isCompilationRuntimeError, isTestError
+	return false, false
 }
 
+// Skips the edges that no path has started processing. Edges being processed hold their ProcessingMutex and are
+// left to finish, since their goroutine writes them while the listener reads them on each status change
 func signalCancelProcessing(allPaths []Path) {
 	for _, path := range allPaths {
 
 		for _, edge := range path.Edges {
+			if !edge.ProcessingMutex.TryLock() {
+				continue
+			}
+
 			if edge.GetStatus() == PENDING || edge.GetStatus() == PROCESSING {
 				edge.SetStatus(SKIPPED_TRANSLATION_FOUND)
 			}
+
+			edge.ProcessingMutex.Unlock()
 		}
 	}
 }
 
-func processParentEdge(edge *TranslationEdge, translationPath Path, allPaths []Path) {
-	parentEdge := edge.ParentEdge
+func processParentEdge(ctx context.Context, edge *TranslationEdge, translationPath Path, allPaths []Path, repairer *EdgeRepairer) {
+	//A repaired parent continues from its last repair attempt
+	parentEdge := edge.ParentEdge.Resolved()
 
 	switch parentEdge.GetStatus() {
-	case FAILED, SKIPPED_PARENT_FAILED, FAILED_NO_EXTRACTED, FAILED_NO_INFERENCE, FAILED_EXECUTION, FAILED_VERIFICATION, FAILED_EXECUTION_TIMEOUT:
+	case FAILED, SKIPPED_PARENT_FAILED, FAILED_NO_EXTRACTED, FAILED_NO_INFERENCE, FAILED_EXECUTION, FAILED_VERIFICATION, FAILED_EXECUTION_TIMEOUT, FAILED_SYNTAX, FAILED_COMPILATION, FAILED_RUNTIME:
 		edge.SetStatus(SKIPPED_PARENT_FAILED)
+	case CANCELLED:
+		edge.SetStatus(CANCELLED)
+	case BUDGET_EXHAUSTED:
+		edge.SetStatus(BUDGET_EXHAUSTED)
 	case TRANSLATION_FOUND, SKIPPED_TRANSLATION_FOUND:
 		edge.SetStatus(SKIPPED_TRANSLATION_FOUND)
-		if common.ConfigStore.EarlyStopOnTranslationSuccess {
+		if edge.GetConfig().EarlyStopOnTranslationSuccess {
 			signalCancelProcessing(allPaths)
 		}
 	case SUCCESS, TRANSLATED:
 		edge.SourceCode = parentEdge.ExtractedSourceCode
 		prompt := PreparePrompt(edge)
 		edge.Prompt = prompt
-		PerformTranslationStep(edge, translationPath.FinalTarget)
+		PerformTranslationStep(ctx, edge, translationPath.FinalTarget)
+		repairer.Repair(ctx, edge, translationPath.FinalTarget)
 	default:
 		fmt.Println(parentEdge.GetStatus())
 		panic("There is a bug. Code should not reach here ever")
 	}
 }
 
-func processRootNode(edge *TranslationEdge, translationPath Path, allPaths []Path) {
+func processRootNode(ctx context.Context, edge *TranslationEdge, translationPath Path, allPaths []Path, repairer *EdgeRepairer) {
 	if edge.GetStatus() != SKIPPED_TRANSLATION_FOUND {
 		prompt := PreparePrompt(edge)
 		edge.Prompt = prompt
-		PerformTranslationStep(edge, translationPath.FinalTarget)
-		if edge.GetStatus() == TRANSLATION_FOUND && common.ConfigStore.EarlyStopOnTranslationSuccess {
+		PerformTranslationStep(ctx, edge, translationPath.FinalTarget)
+		repairer.Repair(ctx, edge, translationPath.FinalTarget)
+		if edge.Resolved().GetStatus() == TRANSLATION_FOUND && edge.GetConfig().EarlyStopOnTranslationSuccess {
 			signalCancelProcessing(allPaths)
 		}
 	}
 }
 
-func processTranslationPath(translationPath Path, allPaths []Path, processedChannel chan Path, progressbar *uiprogress.Bar, wg *sync.WaitGroup) {
+// Failed edges are repaired before the next edge of the path, unless the repairer is nil
+func processTranslationPath(ctx context.Context, translationPath Path, allPaths []Path, processedChannel chan Path, progressbar *uiprogress.Bar, wg *sync.WaitGroup, repairer *EdgeRepairer) {
 	defer wg.Done()
 
 	for _, edge := range translationPath.Edges {
@@ -398,10 +494,16 @@ func processTranslationPath(translationPath Path, allPaths []Path, processedChan
 		//If the current edge is not pending, it was already explored in another sub path and we can reuse it
 		if edge.GetStatus() == PENDING {
 			translationPath.UsedMemoizedEdgeIndex = append(translationPath.UsedMemoizedEdgeIndex, false)
-			if edge.ParentEdge != nil {
-				processParentEdge(edge, translationPath, allPaths)
+			if ctx.Err() != nil {
+				//The request was cancelled, so the remaining edges are not processed
+				edge.SetStatus(CANCELLED)
+			} else if edge.Budget.Exhausted() != "" && !edge.SampleGroup.Started() {
+				//No new inferences once a budget is spent, but siblings can still use the samples already generated
+				edge.SetStatus(BUDGET_EXHAUSTED)
+			} else if edge.ParentEdge != nil {
+				processParentEdge(ctx, edge, translationPath, allPaths, repairer)
 			} else {
-				processRootNode(edge, translationPath, allPaths)
+				processRootNode(ctx, edge, translationPath, allPaths, repairer)
 			}
 		} else {
 			translationPath.UsedMemoizedEdgeIndex = append(translationPath.UsedMemoizedEdgeIndex, true)
@@ -529,6 +631,26 @@ func locateFunctionNameAndBodyPython(code string) (string, string) {
 	return functionName, functionBody
 }
 
+// Languages whose functions can be extracted to fill the TransCoder tests
+var transcoderTestLanguages = map[string]bool{
+	"Java":   true,
+	"C++":    true,
+	"Python": true,
+}
+
+// Fills {comment_separator} in the TransCoder prompts
+var commentSeparators = map[string]string{
+	"Python":     "#",
+	"Java":       "//",
+	"C++":        "//",
+	"Go":         "//",
+	"JavaScript": "//",
+	"Rust":       "//",
+}
+
+// FIXME: The regex of verification requests is hardcoded
+const verificationRegexTemplate = "temperature"
+
 func ExtractFunctionForTranscoderTests(translationEdge *TranslationEdge) string {
 	var extractedFunction string
 
@@ -549,8 +671,6 @@ func ExtractFunctionForTranscoderTests(translationEdge *TranslationEdge) string
 	case "Python":
 		functionName, functionBody := locateFunctionNameAndBodyPython(translationEdge.ExtractedSourceCode)
 		extractedFunction = strings.ReplaceAll(functionBody, functionName, "f_filled")
-	default:
-		panic("Unsupported language for TransCoder Test Suite")
 	}
 
 	return extractedFunction
@@ -569,22 +689,17 @@ func PreparePrompt(translationEdge *TranslationEdge) string {
 		prompt = strings.ReplaceAll(prompt, "{signature}", translationEdge.SuggestedTargetSignature)
 	}
 
-	//This is specific to the Transcoder Prompt
+	//Repair prompts refer back to the program that was translated and the errors of the previous attempt
+	if translationEdge.ErrorFeedback != "" {
+		translation := translationEdge.RepairedTranslation()
+		prompt = strings.ReplaceAll(prompt, "{error_feedback}", translationEdge.ErrorFeedback)
+		prompt = strings.ReplaceAll(prompt, "{seed_code}", translation.SourceCode)
+		prompt = strings.ReplaceAll(prompt, "{seed_lang}", translation.InputLanguage)
+	}
+
+	//This is specific to the Transcoder Prompt. Requests with other languages are rejected by ValidateTranslationRequest
 	if common.ConfigStore.UseTranscoderTestFormat {
-		switch translationEdge.TargetLanguage {
-		case "Python":
-			prompt = strings.ReplaceAll(prompt, "{comment_separator}", "#")
-		case "Java", "C++":
-			prompt = strings.ReplaceAll(prompt, "{comment_separator}", "//")
-		case "Go":
-			prompt = strings.ReplaceAll(prompt, "{comment_separator}", "//")
-		case "JavaScript":
-			prompt = strings.ReplaceAll(prompt, "{comment_separator}", "//")
-		case "Rust":
-			prompt = strings.ReplaceAll(prompt, "{comment_separator}", "//")
-		default:
-			panic("Unsupported language comment separator")
-		}
+		prompt = strings.ReplaceAll(prompt, "{comment_separator}", commentSeparators[translationEdge.TargetLanguage])
 	}
 
 	return prompt
@@ -612,20 +727,108 @@ func ExtractSourceCode(originalPrompt string, regexTemplate string, inferenceRes
 	}
 }
 
-func PerformEdgeExecution(translationEdge *TranslationEdge, finalPathTarget string) {
-	executorQueue := GetExecutorQueueInstance()
+// Sends the unit to the executor workers and waits for the result. Returns false if the request was cancelled
+func submitExecution(ctx context.Context, executionUnit *ExecutionUnit) (ExecutionUnit, bool) {
+	executionUnit.SetContext(ctx)
+
+	select {
+	case GetExecutorQueueInstance().InputChannel <- *executionUnit:
+	case <-ctx.Done():
+		return *executionUnit, false
+	}
+
+	executionResult := <-executionUnit.OutputChannel
+
+	return executionResult, !executionResult.Cancelled
+}
+
+// Runs the code of the edge with the input of every fuzzy test in a single execution. Returns the result of each
+// test as if it had been executed on its own
+func submitBatchExecution(ctx context.Context, translationEdge *TranslationEdge) ([]ExecutionUnit, bool) {
+	inputs := []string{}
+
+	for _, test := range translationEdge.FuzzyTests {
+		inputs = append(inputs, test.Input)
+	}
+
+	executionUnit := &ExecutionUnit{
+		SourceCode:     translationEdge.ExtractedSourceCode,
+		Language:       translationEdge.TargetLanguage,
+		BatchStdinData: inputs,
+		OutputChannel:  make(chan ExecutionUnit),
+		ExecutionType:  BATCH,
+	}
+
+	executionResult, ok := submitExecution(ctx, executionUnit)
+
+	if !ok {
+		return nil, false
+	}
+
+	results := []ExecutionUnit{}
+
+	for index, input := range inputs {
+		//Executions that could not be run at all have no results for their inputs
+		result := ExecutionUnit{
+			StdinData:          input,
+			SourceCode:         executionResult.SourceCode,
+			Language:           executionResult.Language,
+			ExecutionOutput:    executionResult.ExecutionOutput,
+			ExecutedCode:       executionResult.ExecutedCode,
+			ExecutionType:      RUN,
+			UsedExecutionCache: executionResult.UsedExecutionCache,
+			Phases:             executionResult.Phases,
+			Stderr:             executionResult.Stderr,
+			ExitCode:           executionResult.ExitCode,
+			PeakMemory:         executionResult.PeakMemory,
+		}
+
+		//The peak memory is only measured for the whole batch
+		if index < len(executionResult.CaseResults) {
+			caseResult := executionResult.CaseResults[index]
+			result.ExecutionOutput = caseResult.Output
+			result.Success = caseResult.Success
+			result.WallTime = caseResult.WallTime
+			result.Phases = caseResult.Phases
+			result.Stdout = caseResult.Stdout
+			result.Stderr = caseResult.Stderr
+			result.ExitCode = caseResult.ExitCode
+		}
+
+		results = append(results, result)
+	}
+
+	return results, true
+}
+
+func PerformEdgeExecution(ctx context.Context, translationEdge *TranslationEdge, finalPathTarget string) {
 	fuzzyPassed := 0
 	totalFuzzyTests := len(translationEdge.FuzzyTests)
 	unitTestPassed := 0
 	totalUnitTests := len(translationEdge.UnitTests)
 	totalExecutionTime := time.Duration(0)
-	//TODO: This is a common variable because we don't support both fuzzy and unit at the same time
 	//TODO: Disabled for now as it is necessary for PerformVerification functionality. Enabling this returns without executing all tests when one fails
 	//finishEarly := false
 
 	//Verify the results using the fuzzy test cases
 	if translationEdge.FuzzyTests != nil {
 
+		//Containers with batch execution compile the code once for all the fuzzy tests
+		var batchResults []ExecutionUnit
+
+		if GetExecutorForLanguageMap()[translationEdge.TargetLanguage].BatchExecution {
+			var ok bool
+			batchResults, ok = submitBatchExecution(ctx, translationEdge)
+
+			if !ok {
+				translationEdge.UpdatePendingStatus(CANCELLED)
+				return
+			}
+
+			//The whole batch is a single execution
+			translationEdge.Budget.RecordExecution()
+		}
+
 		for index, test := range translationEdge.FuzzyTests {
 
 			//FIXME: Disabled for now. This returns without executing all tests when one fails
@@ -633,22 +836,36 @@ func PerformEdgeExecution(translationEdge *TranslationEdge, finalPathTarget stri
 			// 	break
 			// }
 
-			executionUnit := &ExecutionUnit{
-				StdinData:     test.Input,
-				SourceCode:    translationEdge.ExtractedSourceCode,
-				Language:      translationEdge.TargetLanguage,
-				OutputChannel: make(chan ExecutionUnit),
-				ExecutionType: RUN,
+			var executionResult ExecutionUnit
+
+			if batchResults != nil {
+				executionResult = batchResults[index]
+			} else {
+				executionUnit := &ExecutionUnit{
+					StdinData:     test.Input,
+					SourceCode:    translationEdge.ExtractedSourceCode,
+					Language:      translationEdge.TargetLanguage,
+					OutputChannel: make(chan ExecutionUnit),
+					ExecutionType: RUN,
+				}
+
+				//Send for execution
+				var ok bool
+				executionResult, ok = submitExecution(ctx, executionUnit)
+
+				if !ok {
+					translationEdge.UpdatePendingStatus(CANCELLED)
+					return
+				}
+
+				translationEdge.Budget.RecordExecution()
 			}
 
-			//Send for execution
-			executorQueue.InputChannel <- *executionUnit
-			executionResult := <-executionUnit.OutputChannel
 			totalExecutionTime += executionResult.WallTime
 
 			//FIXME: Exit early if at least one of the tests fails to save computing
 			if !executionResult.Success {
-				translationEdge.UpdatePendingStatus(FAILED_EXECUTION)
+				translationEdge.UpdatePendingStatus(ExecutionFailureStatus(executionResult.Phases))
 				// finishEarly = true
 			} else if executionResult.ExecutionOutput == "CMD_TIMEOUT_KILLED" {
 				translationEdge.UpdatePendingStatus(FAILED_EXECUTION_TIMEOUT)
@@ -658,8 +875,11 @@ func PerformEdgeExecution(translationEdge *TranslationEdge, finalPathTarget stri
 				// finishEarly = true
 			}
 
-			//TODO: Could be better but depends on how we measure this
-			if strings.TrimSpace(executionResult.ExecutionOutput) == strings.TrimSpace(test.ExpectedOutput) {
+			//Comparators were validated when the test suite was attached
+			comparatorName, comparator, _ := GetComparator(test.Comparator)
+			translationEdge.FuzzyTests[index].ComparatorName = comparatorName
+
+			if comparator.Compare(executionResult.ExecutionOutput, test.ExpectedOutput) {
 				fuzzyPassed++
 				translationEdge.FuzzyTests[index].Passed = true
 			} else {
@@ -671,6 +891,12 @@ func PerformEdgeExecution(translationEdge *TranslationEdge, finalPathTarget stri
 			translationEdge.FuzzyTests[index].ActualOutput = strings.TrimSpace(executionResult.ExecutionOutput)
 			translationEdge.FuzzyTests[index].ExecutedCode = executionResult.ExecutedCode
 			translationEdge.FuzzyTests[index].ExitCodeZero = executionResult.Success
+			translationEdge.FuzzyTests[index].Phases = executionResult.Phases
+			translationEdge.FuzzyTests[index].Stdout = executionResult.Stdout
+			translationEdge.FuzzyTests[index].Stderr = executionResult.Stderr
+			translationEdge.FuzzyTests[index].ExitCode = executionResult.ExitCode
+			translationEdge.FuzzyTests[index].WallTime = executionResult.WallTime
+			translationEdge.FuzzyTests[index].PeakMemory = executionResult.PeakMemory
 		}
 
 	}
@@ -714,13 +940,20 @@ func PerformEdgeExecution(translationEdge *TranslationEdge, finalPathTarget stri
 			}
 
 			//Send for execution
-			executorQueue.InputChannel <- *executionUnit
-			executionResult := <-executionUnit.OutputChannel
+			executionResult, ok := submitExecution(ctx, executionUnit)
+
+			if !ok {
+				translationEdge.UpdatePendingStatus(CANCELLED)
+				return
+			}
+
+			translationEdge.Budget.RecordExecution()
+
 			totalExecutionTime += executionResult.WallTime
 
 			//TODO: Exit early if at least one of the tests fails to save computing
 			if !executionResult.Success {
-				translationEdge.UpdatePendingStatus(FAILED_EXECUTION)
+				translationEdge.UpdatePendingStatus(ExecutionFailureStatus(executionResult.Phases))
 				// finishEarly = true
 			} else if executionResult.ExecutionOutput == "CMD_TIMEOUT_KILLED" {
 				translationEdge.UpdatePendingStatus(FAILED_EXECUTION_TIMEOUT)
@@ -757,6 +990,12 @@ func PerformEdgeExecution(translationEdge *TranslationEdge, finalPathTarget stri
 			translationEdge.UnitTests[index].ActualOutput = executionResult.ExecutionOutput
 			translationEdge.UnitTests[index].ExecutedCode = executionResult.ExecutedCode
 			translationEdge.UnitTests[index].ExitCodeZero = executionResult.Success
+			translationEdge.UnitTests[index].Phases = executionResult.Phases
+			translationEdge.UnitTests[index].Stdout = executionResult.Stdout
+			translationEdge.UnitTests[index].Stderr = executionResult.Stderr
+			translationEdge.UnitTests[index].ExitCode = executionResult.ExitCode
+			translationEdge.UnitTests[index].WallTime = executionResult.WallTime
+			translationEdge.UnitTests[index].PeakMemory = executionResult.PeakMemory
 		}
 
 	}
@@ -774,31 +1013,86 @@ func PerformEdgeExecution(translationEdge *TranslationEdge, finalPathTarget stri
 	}
 }
 
-func PerformTranslationStep(translationEdge *TranslationEdge, finalPathTarget string) {
-	translationEdge.SetStatus(PROCESSING)
+// Queues the inference of the prompt of an edge and waits for its result
+func submitInference(ctx context.Context, translationEdge *TranslationEdge, samples int) InferenceResult {
+	if !translationEdge.Budget.ReserveInference() {
+		return InferenceResult{BudgetExhausted: true}
+	}
 
 	inferenceQueue := GetInferenceQueueInstance()
 
-	var inferenceResult InferenceResult
-
 	inferenceUnit := &InferenceUnit{
 		Prompt:        translationEdge.Prompt,
 		ModelName:     translationEdge.ModelName,
 		OutputChannel: make(chan InferenceResult, 1),
+		Config:        translationEdge.GetConfig(),
+		N:             samples,
+		LogProbs:      translationEdge.GetConfig().SearchScorer == LogProbScorerName,
+	}
+	inferenceUnit.SetContext(ctx)
+
+	select {
+	case inferenceQueue.InputChannel <- *inferenceUnit:
+	case <-ctx.Done():
+		translationEdge.Budget.RecordInference(InferenceResult{Cancelled: true})
+		return InferenceResult{Cancelled: true}
+	}
+
+	inferenceResult := <-inferenceUnit.OutputChannel
+	translationEdge.Budget.RecordInference(inferenceResult)
+
+	return inferenceResult
+}
+
+func PerformTranslationStep(ctx context.Context, translationEdge *TranslationEdge, finalPathTarget string) {
+	translationEdge.SetStatus(PROCESSING)
+
+	var inferenceResult InferenceResult
+	inferenceStart := time.Now()
+
+	//Sibling candidates share the samples of a single request, and each one takes its own
+	if translationEdge.SampleGroup != nil {
+		groupResult, performed := translationEdge.SampleGroup.Result(func(samples int) InferenceResult {
+			return submitInference(ctx, translationEdge, samples)
+		})
+		inferenceResult = groupResult.Sample(translationEdge.SampleIndex, performed)
+	} else {
+		inferenceResult = submitInference(ctx, translationEdge, 1)
+	}
+
+	translationEdge.WallClockInferenceTime = time.Since(inferenceStart)
+
+	if inferenceResult.Cancelled {
+		translationEdge.UpdatePendingStatus(CANCELLED)
+		return
+	}
+
+	if inferenceResult.BudgetExhausted {
+		translationEdge.UpdatePendingStatus(BUDGET_EXHAUSTED)
+		return
 	}
 
-	inferenceQueue.InputChannel <- *inferenceUnit
-	inferenceResult = <-inferenceUnit.OutputChannel
 	translationEdge.InferenceOutput = inferenceResult.Response
 	translationEdge.UsedInferenceCache = inferenceResult.IsCached
+	translationEdge.Usage = inferenceResult.Usage
+	translationEdge.LogProb = inferenceResult.LogProb
 
 	if !inferenceResult.Success {
 		translationEdge.SetStatus(FAILED_NO_INFERENCE)
 		return
 	}
 
-	//Extract the source code
-	extracted, extractedOk := ExtractSourceCode(translationEdge.Prompt, translationEdge.RegexTemplate, inferenceResult.Response)
+	//Extract the source code. The extraction was validated with the request
+	extractCode, err := GetCodeExtraction(translationEdge.GetConfig())
+
+	if err != nil {
+		fmt.Printf("Error extracting the translation: %v\n", err)
+		translationEdge.SetStatus(FAILED_NO_EXTRACTED)
+		return
+	}
+
+	extracted, strategy, extractedOk := extractCode(translationEdge, inferenceResult.Response)
+	translationEdge.ExtractionStrategy = strategy
 
 	//Can't process downstream edges as we weren't able to extract the code
 	if !extractedOk {
@@ -808,12 +1102,27 @@ func PerformTranslationStep(translationEdge *TranslationEdge, finalPathTarget st
 
 	translationEdge.ExtractedSourceCode = extracted
 
-	if !common.ConfigStore.VerifyIntermediateTranslations && translationEdge.TargetLanguage != finalPathTarget {
+	if !translationEdge.GetConfig().VerifyIntermediateTranslations && translationEdge.TargetLanguage != finalPathTarget {
 		translationEdge.UpdatePendingStatus(TRANSLATED)
 		return
 	}
 
-	PerformEdgeExecution(translationEdge, finalPathTarget)
+	//Code that doesn't parse would fail every test, so they are not executed
+	if translationEdge.GetConfig().SyntaxPreCheck {
+		passed, ok := PerformSyntaxCheck(ctx, translationEdge)
+
+		if !ok {
+			translationEdge.UpdatePendingStatus(CANCELLED)
+			return
+		}
+
+		if !passed {
+			translationEdge.SetStatus(FAILED_SYNTAX)
+			return
+		}
+	}
+
+	PerformEdgeExecution(ctx, translationEdge, finalPathTarget)
 }
 
 func VerifyTranscoderTestCase(input string) (bool, error) {
@@ -841,6 +1150,7 @@ func GetPromptTemplate(templateName string) string {
 
 	}
 
+	//Templates are checked by ValidateTranslationRequest, so this is a bug
 	panic("Requested template not found")
 }
 
@@ -854,25 +1164,14 @@ func GetRegexTemplate(templateName string) string {
 
 	}
 
+	//Templates are checked by ValidateTranslationRequest, so this is a bug
 	panic("Requested regex template not found")
 }
 
-func RequestTranslationDirectorCAK(translationRequest *TranslationRequest, wtg *sync.WaitGroup, progressbar *uiprogress.Bar, responseChannel chan *TranslationResponse, semaphore *semaphore.Weighted) {
+func RequestTranslationDirectorCAK(ctx context.Context, translationRequest *TranslationRequest, wtg *sync.WaitGroup, progressbar *uiprogress.Bar, responseChannel chan *TranslationResponse, semaphore *semaphore.Weighted, batchBudget *BudgetTracker) {
 	defer wtg.Done()
 	defer semaphore.Release(1)
 
-	if common.ConfigStore.ExpansionDepth != 1 {
-		panic("You need only direct translations")
-	}
-
-	if common.ConfigStore.Seed != -1 {
-		panic("You need to disable the seed")
-	}
-
-	if common.ConfigStore.UseInferenceCache {
-		panic("Inference cache must not be used for CA@k")
-	}
-
 	if common.ConfigStore.UseResponseCache {
 		//Try to load from cache if this was already processed in another run
 		response, err := LoadExistingResponse(translationRequest)
@@ -889,6 +1188,7 @@ func RequestTranslationDirectorCAK(translationRequest *TranslationRequest, wtg *
 
 	}
 
+	config := RequestConfig(translationRequest)
 	promptTemplate := GetPromptTemplate(translationRequest.PromptTemplateName)
 	regexTemplate := GetRegexTemplate(translationRequest.RegexTemplateName)
 
@@ -897,14 +1197,18 @@ func RequestTranslationDirectorCAK(translationRequest *TranslationRequest, wtg *
 
 	translationPaths := []Path{}
 
-	for range 10 {
+	//The samples are generated with a single inference request and shared by the edges
+	sampleGroup := NewSampleGroup(10)
+	budget := NewBudgetTracker(translationRequest.Budget, batchBudget)
+
+	for sampleIndex := range 10 {
 
 		edge := &TranslationEdge{
 			Id:              counter.Next(),
 			TranslationId:   translationRequest.Id,
 			InputLanguage:   translationRequest.SeedLanguage,
 			TargetLanguage:  translationRequest.TargetLanguage,
-			Level:           common.ConfigStore.ExpansionDepth,
+			Level:           config.ExpansionDepth,
 			ProcessingMutex: &sync.Mutex{},
 			StatusMutex:     &sync.Mutex{},
 			SourceCode:      translationRequest.SeedCode,
@@ -914,6 +1218,10 @@ func RequestTranslationDirectorCAK(translationRequest *TranslationRequest, wtg *
 			RegexTemplate:   regexTemplate,
 			ModelName:       translationRequest.ModelName,
 			ExtraPromptData: translationRequest.ExtraPromptData,
+			Config:          config,
+			SampleGroup:     sampleGroup,
+			SampleIndex:     sampleIndex,
+			Budget:          budget,
 		}
 
 		//Make sure to include unit tests in the edge
@@ -957,10 +1265,10 @@ func RequestTranslationDirectorCAK(translationRequest *TranslationRequest, wtg *
 		wg.Add(1)
 
 		//Disable concurrent branch processing for compute saving mode
-		if common.ConfigStore.ComputeEfficientMode {
-			processTranslationPath(path, allPaths, processedChannel, progressbar, &wg)
+		if config.ComputeEfficientMode {
+			processTranslationPath(ctx, path, allPaths, processedChannel, progressbar, &wg, nil)
 		} else {
-			go processTranslationPath(path, allPaths, processedChannel, progressbar, &wg)
+			go processTranslationPath(ctx, path, allPaths, processedChannel, progressbar, &wg, nil)
 		}
 
 	}
@@ -971,25 +1279,29 @@ func RequestTranslationDirectorCAK(translationRequest *TranslationRequest, wtg *
 	//All goroutines ended, so we are not expecting new values
 	close(processedChannel)
 	translationResponse := ConvertPathsToResponse(processedChannel, translationRequest)
+	translationResponse.BudgetUsage = budget.ToResponse()
 
-	if common.ConfigStore.UseResponseCache {
+	//Cancelled responses and responses stopped by a budget are incomplete, so they are not cached
+	if common.ConfigStore.UseResponseCache && ctx.Err() == nil && translationResponse.BudgetUsage.ExhaustedBudget == "" {
 		common.SaveResponseToCache(translationRequest, translationResponse)
 	}
 	responseChannel <- translationResponse
 }
 
-func RequestTranslationDirector(translationRequest *TranslationRequest, wtg *sync.WaitGroup, progressbar *uiprogress.Bar, responseChannel chan *TranslationResponse, semaphore *semaphore.Weighted) {
+func RequestTranslationDirector(ctx context.Context, translationRequest *TranslationRequest, wtg *sync.WaitGroup, progressbar *uiprogress.Bar, responseChannel chan *TranslationResponse, semaphore *semaphore.Weighted, listener *BatchListener, batchBudget *BudgetTracker) {
 	defer wtg.Done()
 	defer semaphore.Release(1)
 
+	config := RequestConfig(translationRequest)
+
 	if common.ConfigStore.UseResponseCache {
 		//Try to load from cache if this was already processed in another run
 		response, err := LoadExistingResponse(translationRequest)
 
 		if !err {
 			fmt.Println("Used from cache")
-			//FIXME: This should not be hardcoded
-			for i := 0; i < 21; i++ {
+			totalPaths, _ := CountIntermediatesTranslationTree(translationRequest.UsedLanguages, translationRequest.SeedLanguage, translationRequest.TargetLanguage, config.ExpansionDepth, config.GetCandidatesPerEdge())
+			for i := 0; i < totalPaths; i++ {
 				progressbar.Incr()
 			}
 			responseChannel <- &response
@@ -998,69 +1310,44 @@ func RequestTranslationDirector(translationRequest *TranslationRequest, wtg *syn
 
 	}
 
-	initialPath := &Path{
-		FinalTarget: translationRequest.TargetLanguage,
-	}
-	maxDepth := common.ConfigStore.ExpansionDepth
+	//The strategy was validated with the request
+	strategy, _ := GetSearchStrategy(config)
 
-	promptTemplate := GetPromptTemplate(translationRequest.PromptTemplateName)
-	regexTemplate := GetRegexTemplate(translationRequest.RegexTemplateName)
-
-	//For the Edge Id
-	counter := NewCounter()
-
-	translationPaths := &TranslationPaths{
-		Paths: []Path{},
+	search := &ToCTSearch{
+		Request:        translationRequest,
+		Config:         config,
+		PromptTemplate: GetPromptTemplate(translationRequest.PromptTemplateName),
+		RegexTemplate:  GetRegexTemplate(translationRequest.RegexTemplateName),
+		Budget:         NewBudgetTracker(translationRequest.Budget, batchBudget),
+		Listener:       listener,
+		Progressbar:    progressbar,
+		Counter:        NewCounter(), //For the Edge Id
 	}
 
-	BuildIntermediatesTranslationTree(translationRequest, promptTemplate, regexTemplate, translationRequest.UsedLanguages, translationRequest.SeedLanguage, translationRequest.TargetLanguage, translationRequest.SeedCode, 1, maxDepth, nil, initialPath, translationPaths, counter)
-
-	allPaths := translationPaths.Paths
-
-	//Sort them to prioritize translations to the request target
-	PrioritizeShallowFirst(allPaths)
-
-	// fmt.Println(len(allPaths))
-
-	// levels := make(map[int]int)
-
-	// for _, cpath := range allPaths {
-	// 	for _, edge := range cpath.Edges {
-	// 		levels[edge.Level] += 1
-	// 	}
-	// }
+	//Repair edges take their ids from the same counter
+	search.Repairer = NewEdgeRepairer(translationRequest, config, search.Counter)
 
-	// fmt.Println(levels)
+	processedChannel := strategy.Search(ctx, search)
 
-	// panic("test")
+	translationResponse := ConvertPathsToResponse(processedChannel, translationRequest)
+	translationResponse.BudgetUsage = search.Budget.ToResponse()
 
-	processedChannel := make(chan Path, len(allPaths))
+	RecordStatistics(translationResponse)
 
-	wg := sync.WaitGroup{}
+	//Cancelled responses and responses stopped by a budget are incomplete, so they are not cached
+	if common.ConfigStore.UseResponseCache && ctx.Err() == nil && translationResponse.BudgetUsage.ExhaustedBudget == "" {
+		common.SaveResponseToCache(translationRequest, translationResponse)
+	}
+	responseChannel <- translationResponse
+}
 
+// Every edge of the request is charged to the same budget
+func attachBudget(allPaths []Path, budget *BudgetTracker) {
 	for _, path := range allPaths {
-		wg.Add(1)
-
-		//Disable concurrent branch processing for compute saving mode
-		if common.ConfigStore.ComputeEfficientMode {
-			processTranslationPath(path, allPaths, processedChannel, progressbar, &wg)
-		} else {
-			go processTranslationPath(path, allPaths, processedChannel, progressbar, &wg)
+		for _, edge := range path.Edges {
+			edge.Budget = budget
 		}
-
-	}
-
-	// Wait for path translation goroutines to finish
-	wg.Wait()
-
-	//All goroutines ended, so we are not expecting new values
-	close(processedChannel)
-	translationResponse := ConvertPathsToResponse(processedChannel, translationRequest)
-
-	if common.ConfigStore.UseResponseCache {
-		common.SaveResponseToCache(translationRequest, translationResponse)
 	}
-	responseChannel <- translationResponse
 }
 
 func ConvertPathsToResponse(paths chan Path, translationRequest *TranslationRequest) *TranslationResponse {
@@ -1069,14 +1356,26 @@ func ConvertPathsToResponse(paths chan Path, translationRequest *TranslationRequ
 	for path := range paths {
 
 		responseEdges := []*ResponseTranslationEdge{}
+		memoized := []bool{}
 
-		for _, edge := range path.Edges {
+		for index, edge := range path.Edges {
 			responseEdges = append(responseEdges, ConvertToEdgeResponse(edge))
+
+			//Repair attempts follow the edge they repair, and are memoized with it
+			for _, repairEdge := range edge.RepairEdges {
+				responseEdges = append(responseEdges, ConvertToEdgeResponse(repairEdge))
+			}
+
+			if index < len(path.UsedMemoizedEdgeIndex) {
+				for attempt := 0; attempt <= len(edge.RepairEdges); attempt++ {
+					memoized = append(memoized, path.UsedMemoizedEdgeIndex[index])
+				}
+			}
 		}
 
 		responsePath := &ResponseTranslationPath{
 			TranslationEdges:  responseEdges,
-			EdgeIndexMemoized: path.UsedMemoizedEdgeIndex,
+			EdgeIndexMemoized: memoized,
 		}
 
 		responsePaths = append(responsePaths, responsePath)
@@ -1086,6 +1385,7 @@ func ConvertPathsToResponse(paths chan Path, translationRequest *TranslationRequ
 		TranslationRequest: translationRequest,
 		Paths:              responsePaths,
 	}
+	response.Usage = SumResponseTokenUsage(response)
 
 	return response
 }
@@ -1113,6 +1413,7 @@ func ConvertToEdgeResponse(edge *TranslationEdge) *ResponseTranslationEdge {
 
 	responseEdge := &ResponseTranslationEdge{
 		EdgeId:                int32(edge.Id),
+		TranslationId:         edge.TranslationId,
 		PromptTemplate:        edge.PromptTemplate,
 		Prompt:                edge.Prompt,
 		InputLanguage:         edge.InputLanguage,
@@ -1129,6 +1430,13 @@ func ConvertToEdgeResponse(edge *TranslationEdge) *ResponseTranslationEdge {
 		WallTimeInference:     edge.WallClockInferenceTime.Milliseconds(),
 		WallTimeTestExecution: edge.WallClockTestExecutionTime.Milliseconds(),
 		UsedInferenceCache:    edge.UsedInferenceCache,
+		FailedTestCategories:  edge.FailedTestCategories(),
+		CandidateIndex:        int32(edge.SampleIndex),
+		Usage:                 edge.Usage.ToResponse(edge.ModelName),
+		LogProb:               edge.LogProb,
+		RepairAttempt:         int32(edge.RepairAttempt),
+		ExtractionStrategy:    edge.ExtractionStrategy,
+		ExecutionOutput:       edge.ExecutionOutput,
 	}
 
 	return responseEdge
@@ -1165,6 +1473,12 @@ func ConvertFromEdgeResponse(responseEdge *ResponseTranslationEdge) *Translation
 		WallClockInferenceTime:     time.Duration(responseEdge.WallTimeInference) * time.Millisecond,
 		WallClockTestExecutionTime: time.Duration(responseEdge.WallTimeTestExecution) * time.Millisecond,
 		UsedInferenceCache:         responseEdge.UsedInferenceCache,
+		SampleIndex:                int(responseEdge.CandidateIndex),
+		Usage:                      common.FromResponseTokenUsage(responseEdge.Usage),
+		LogProb:                    responseEdge.LogProb,
+		RepairAttempt:              int(responseEdge.RepairAttempt),
+		ExtractionStrategy:         responseEdge.ExtractionStrategy,
+		ExecutionOutput:            responseEdge.ExecutionOutput,
 	}
 
 	edge.SetStatus(common.ParseStatus(responseEdge.Status))
@@ -1196,16 +1510,15 @@ func AttachTargetSignatureFromRequest(translationEdge *TranslationEdge, translat
 
 func AttachTestSuiteFromRequest(translationEdge *TranslationEdge, translationRequest *TranslationRequest) {
 
-	if translationRequest.TestSuite == nil || len(translationRequest.TestSuite.UnitTestSuite) == 0 && len(translationRequest.TestSuite.FuzzySuite) == 0 {
-		panic("Please provide unit tests")
-	}
-
+	//The test suite was checked by ValidateTranslationRequest
 	//Fuzzy tests are language independent so we can evaluate all of them
 	for _, test := range translationRequest.TestSuite.FuzzySuite {
+		comparator := ResolveFuzzyComparator(translationRequest.TestSuite, test)
 
 		fuzzyTest := FuzzyTest{
 			Input:          test.StdinInput,
 			ExpectedOutput: test.ExpectedOutput,
+			Comparator:     comparator,
 		}
 
 		translationEdge.FuzzyTests = append(translationEdge.FuzzyTests, fuzzyTest)
@@ -1222,11 +1535,6 @@ func AttachTestSuiteFromRequest(translationEdge *TranslationEdge, translationReq
 			}
 		}
 
-		if len(compatibleCases) == 0 && ConfigStore.VerifyIntermediateTranslations {
-			fmt.Println(translationRequest.TargetLanguage)
-			panic("If you use unit tests, you must include test cases for all intermediate languages.")
-		}
-
 		for _, compatibleCase := range compatibleCases {
 			unitTest := UnitTest{
 				SourceCode: compatibleCase.TestCase,
@@ -1238,14 +1546,41 @@ func AttachTestSuiteFromRequest(translationEdge *TranslationEdge, translationReq
 
 	}
 
-	if len(translationRequest.TestSuite.UnitTestSuite) > 0 && len(translationRequest.TestSuite.FuzzySuite) > 0 {
-		panic("We don't yet support evaluation with mixed fuzzy and unit tests. Feel free to contribute to this :=)")
-	}
+}
 
+// Edge of the ToCT from the input language to the language. Only the edges of the first level have the seed code
+func NewTreeEdge(translationRequest *TranslationRequest, config *AppConfig, promptTemplate string, regexTemplate string, inputLanguage string, language string, seedCode string, depth int, parent *TranslationEdge, counter *Counter, sampleGroup *SampleGroup, sampleIndex int) *TranslationEdge {
+	edge := &TranslationEdge{
+		Id:              counter.Next(),
+		TranslationId:   translationRequest.Id,
+		InputLanguage:   inputLanguage,
+		TargetLanguage:  language,
+		Level:           depth,
+		ParentEdge:      parent,
+		ProcessingMutex: &sync.Mutex{},
+		StatusMutex:     &sync.Mutex{},
+		SourceCode:      seedCode,
+		PromptTemplate:  promptTemplate,
+		FuzzyTests:      []FuzzyTest{},
+		UnitTests:       []UnitTest{},
+		RegexTemplate:   regexTemplate,
+		ModelName:       translationRequest.ModelName,
+		Config:          config,
+		SampleGroup:     sampleGroup,
+		SampleIndex:     sampleIndex,
+	}
+
+	//Make sure to include unit tests in the edge
+	AttachTestSuiteFromRequest(edge, translationRequest)
+
+	//Unit tests may need the target signature to be leaked to work
+	AttachTargetSignatureFromRequest(edge, translationRequest)
+
+	return edge
 }
 
 // BuildTranslationTree builds a translation tree and collects all paths.
-func BuildIntermediatesTranslationTree(translationRequest *TranslationRequest, promptTemplate string, regexTemplate string, languages []string, inputLanguage string, requestTargetLanguage string, seedCode string, depth int, maxDepth int, parent *TranslationEdge, currentPath *Path, allPaths *TranslationPaths, counter *Counter) {
+func BuildIntermediatesTranslationTree(translationRequest *TranslationRequest, config *AppConfig, promptTemplate string, regexTemplate string, languages []string, inputLanguage string, requestTargetLanguage string, seedCode string, depth int, maxDepth int, parent *TranslationEdge, currentPath *Path, allPaths *TranslationPaths, counter *Counter) {
 
 	if depth > maxDepth {
 		return
@@ -1253,30 +1588,21 @@ func BuildIntermediatesTranslationTree(translationRequest *TranslationRequest, p
 
 	for _, language := range languages {
 
-		if language != inputLanguage {
-
-			edge := &TranslationEdge{
-				Id:              counter.Next(),
-				TranslationId:   translationRequest.Id,
-				InputLanguage:   inputLanguage,
-				TargetLanguage:  language,
-				Level:           depth,
-				ParentEdge:      parent,
-				ProcessingMutex: &sync.Mutex{},
-				StatusMutex:     &sync.Mutex{},
-				SourceCode:      seedCode,
-				PromptTemplate:  promptTemplate,
-				FuzzyTests:      []FuzzyTest{},
-				UnitTests:       []UnitTest{},
-				RegexTemplate:   regexTemplate,
-				ModelName:       translationRequest.ModelName,
-			}
+		if language == inputLanguage {
+			continue
+		}
 
-			//Make sure to include unit tests in the edge
-			AttachTestSuiteFromRequest(edge, translationRequest)
+		//Sibling candidates for the same language share the samples of a single inference
+		candidates := config.GetCandidatesPerEdge()
+		var sampleGroup *SampleGroup
 
-			//Unit tests may need the target signature to be leaked to work
-			AttachTargetSignatureFromRequest(edge, translationRequest)
+		if candidates > 1 {
+			sampleGroup = NewSampleGroup(candidates)
+		}
+
+		for sampleIndex := 0; sampleIndex < candidates; sampleIndex++ {
+
+			edge := NewTreeEdge(translationRequest, config, promptTemplate, regexTemplate, inputLanguage, language, seedCode, depth, parent, counter, sampleGroup, sampleIndex)
 
 			// Add the current edge to the path
 			newPath := currentPath.Copy()
@@ -1288,10 +1614,64 @@ func BuildIntermediatesTranslationTree(translationRequest *TranslationRequest, p
 				allPaths.Add(finalPath)
 			} else {
 				// Continue to build the tree
-				BuildIntermediatesTranslationTree(translationRequest, promptTemplate, regexTemplate, languages, language, requestTargetLanguage, "", depth+1, maxDepth, edge, newPath, allPaths, counter)
+				BuildIntermediatesTranslationTree(translationRequest, config, promptTemplate, regexTemplate, languages, language, requestTargetLanguage, "", depth+1, maxDepth, edge, newPath, allPaths, counter)
 
 			}
 		}
 	}
 
 }
+
+// Counts the paths and the edges that BuildIntermediatesTranslationTree generates without building the tree.
+// Edges that don't lead to the target language within maxDepth are not part of any path, so they are not counted.
+// Each edge has the given number of sibling candidates
+func CountIntermediatesTranslationTree(languages []string, seedLanguage string, targetLanguage string, maxDepth int, candidates int) (int, int) {
+	type subtreeCount struct {
+		paths int
+		edges int
+	}
+
+	//The subtree below an edge only depends on its target language and depth
+	memo := make(map[string]map[int]subtreeCount)
+
+	var count func(inputLanguage string, depth int) subtreeCount
+	count = func(inputLanguage string, depth int) subtreeCount {
+		if cached, ok := memo[inputLanguage][depth]; ok {
+			return cached
+		}
+
+		result := subtreeCount{}
+
+		if depth <= maxDepth {
+			for _, language := range languages {
+
+				if language == inputLanguage {
+					continue
+				}
+
+				if language == targetLanguage {
+					result.paths += candidates
+					result.edges += candidates
+				} else {
+					subtree := count(language, depth+1)
+
+					if subtree.paths > 0 {
+						result.paths += candidates * subtree.paths
+						result.edges += candidates * (subtree.edges + 1)
+					}
+				}
+			}
+		}
+
+		if memo[inputLanguage] == nil {
+			memo[inputLanguage] = make(map[int]subtreeCount)
+		}
+		memo[inputLanguage][depth] = result
+
+		return result
+	}
+
+	total := count(seedLanguage, 1)
+
+	return total.paths, total.edges
+}
@@ -0,0 +1,93 @@
+package algo
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Run with -race: the listener converts edges while other paths cancel them
+func TestListenerSnapshotsWithCancellation(t *testing.T) {
+	edges := []*TranslationEdge{}
+
+	for id := 0; id < 8; id++ {
+		edges = append(edges, &TranslationEdge{
+			Id:              id,
+			ProcessingMutex: &sync.Mutex{},
+			StatusMutex:     &sync.Mutex{},
+			FuzzyTests:      []FuzzyTest{{Input: "1", ExpectedOutput: "1"}},
+		})
+	}
+
+	allPaths := []Path{{Edges: edges}}
+
+	var events sync.Mutex
+	snapshots := 0
+
+	listener := &BatchListener{
+		OnEdgeStatusChange: func(edge *ResponseTranslationEdge) {
+			events.Lock()
+			snapshots++
+			events.Unlock()
+		},
+	}
+
+	listener.attachToEdges(allPaths)
+
+	var wg sync.WaitGroup
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	processing := edges[:len(edges)/2]
+
+	//The first half of the edges is being written by the paths that process them, as processTranslationPath does
+	for _, edge := range processing {
+		wg.Add(1)
+		edge.ProcessingMutex.Lock()
+		edge.SetStatus(PROCESSING)
+
+		go func(edge *TranslationEdge) {
+			defer wg.Done()
+			defer edge.ProcessingMutex.Unlock()
+
+			started <- struct{}{}
+
+			for {
+				edge.InferenceOutput = "output"
+				edge.ExtractedSourceCode = "code"
+				edge.FuzzyTests[0].ActualOutput = "1"
+
+				select {
+				case <-cancelled:
+					edge.UpdatePendingStatus(TRANSLATED)
+					return
+				default:
+				}
+			}
+		}(edge)
+	}
+
+	for range processing {
+		<-started
+	}
+
+	signalCancelProcessing(allPaths)
+	close(cancelled)
+	wg.Wait()
+
+	for index, edge := range edges {
+		expected := SKIPPED_TRANSLATION_FOUND
+
+		if index < len(processing) {
+			expected = TRANSLATED
+		}
+
+		if status := edge.GetStatus(); status != expected {
+			t.Fatalf("edge %d ended with status %s instead of %s", edge.Id, status, expected)
+		}
+	}
+
+	if snapshots == 0 {
+		t.Fatal("the listener was not notified")
+	}
+}
@@ -0,0 +1,197 @@
+package algo
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Names of the comparators that can be requested in OutputComparator
+const (
+	TrimComparatorName            = "trim"
+	ExactComparatorName           = "exact"
+	WhitespaceComparatorName      = "whitespace"
+	NumericComparatorName         = "numeric"
+	CaseInsensitiveComparatorName = "case_insensitive"
+	RegexComparatorName           = "regex"
+	UnorderedLinesComparatorName  = "unordered_lines"
+)
+
+// Decides if the output of a fuzzy test matches its expected output
+type Comparator interface {
+	Compare(actual string, expected string) bool
+}
+
+type ComparatorFunc func(actual string, expected string) bool
+
+func (f ComparatorFunc) Compare(actual string, expected string) bool {
+	return f(actual, expected)
+}
+
+// Returns the comparator requested for a test. A nil or unnamed request uses the default comparator, which
+// ignores leading and trailing whitespace
+func GetComparator(request *OutputComparator) (string, Comparator, error) {
+	if request == nil || request.Name == "" {
+		return TrimComparatorName, ComparatorFunc(compareTrimmed), nil
+	}
+
+	switch request.Name {
+	case TrimComparatorName:
+		return request.Name, ComparatorFunc(compareTrimmed), nil
+	case ExactComparatorName:
+		return request.Name, ComparatorFunc(func(actual string, expected string) bool { return actual == expected }), nil
+	case WhitespaceComparatorName:
+		return request.Name, ComparatorFunc(compareWhitespaceNormalized), nil
+	case NumericComparatorName:
+		return request.Name, &NumericComparator{AbsoluteTolerance: request.AbsoluteTolerance, RelativeTolerance: request.RelativeTolerance}, nil
+	case CaseInsensitiveComparatorName:
+		return request.Name, ComparatorFunc(func(actual string, expected string) bool {
+			return strings.EqualFold(strings.TrimSpace(actual), strings.TrimSpace(expected))
+		}), nil
+	case RegexComparatorName:
+		return request.Name, ComparatorFunc(compareRegex), nil
+	case UnorderedLinesComparatorName:
+		return request.Name, ComparatorFunc(compareUnorderedLines), nil
+	default:
+		return "", nil, fmt.Errorf("unknown output comparator %s", request.Name)
+	}
+}
+
+// A comparator in the test case takes precedence over the one of the test suite
+func ResolveFuzzyComparator(testSuite *TestSuite, test *FuzzyTestCase) *OutputComparator {
+	if test.Comparator != nil {
+		return test.Comparator
+	}
+
+	return testSuite.FuzzyComparator
+}
+
+// The expected output of a regex test must be a valid expression
+func ValidateFuzzyComparator(request *OutputComparator, expectedOutput string) error {
+	name, _, err := GetComparator(request)
+
+	if err != nil {
+		return err
+	}
+
+	if name == RegexComparatorName {
+		if _, err := regexp.Compile(expectedOutput); err != nil {
+			return fmt.Errorf("invalid expected output for regex comparator: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func compareTrimmed(actual string, expected string) bool {
+	return strings.TrimSpace(actual) == strings.TrimSpace(expected)
+}
+
+// Ignores line endings, repeated spaces inside a line and blank lines at the start or end of the output
+func normalizeLines(output string) []string {
+	output = strings.ReplaceAll(output, "\r\n", "\n")
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+
+	for index, line := range lines {
+		lines[index] = strings.Join(strings.Fields(line), " ")
+	}
+
+	return lines
+}
+
+func compareWhitespaceNormalized(actual string, expected string) bool {
+	actualLines := normalizeLines(actual)
+	expectedLines := normalizeLines(expected)
+
+	if len(actualLines) != len(expectedLines) {
+		return false
+	}
+
+	for index := range actualLines {
+		if actualLines[index] != expectedLines[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// The expected output is a regular expression that must match the whole trimmed output
+func compareRegex(actual string, expected string) bool {
+	re, err := regexp.Compile(`(?s)^(?:` + expected + `)$`)
+
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(strings.TrimSpace(actual))
+}
+
+func compareUnorderedLines(actual string, expected string) bool {
+	actualLines := []string{}
+	expectedLines := []string{}
+
+	for _, line := range normalizeLines(actual) {
+		if line != "" {
+			actualLines = append(actualLines, line)
+		}
+	}
+
+	for _, line := range normalizeLines(expected) {
+		if line != "" {
+			expectedLines = append(expectedLines, line)
+		}
+	}
+
+	sort.Strings(actualLines)
+	sort.Strings(expectedLines)
+
+	return strings.Join(actualLines, "\n") == strings.Join(expectedLines, "\n")
+}
+
+// Compares the outputs token by token. Tokens that are numbers in both outputs are equal if they are within
+// the tolerances, so 1 and 1.0 are equal. Other tokens must be identical
+type NumericComparator struct {
+	AbsoluteTolerance float64
+	RelativeTolerance float64
+}
+
+func (comparator *NumericComparator) Compare(actual string, expected string) bool {
+	actualTokens := strings.Fields(actual)
+	expectedTokens := strings.Fields(expected)
+
+	if len(actualTokens) != len(expectedTokens) {
+		return false
+	}
+
+	for index := range actualTokens {
+		if !comparator.equalTokens(actualTokens[index], expectedTokens[index]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (comparator *NumericComparator) equalTokens(actual string, expected string) bool {
+	actualNumber, actualErr := strconv.ParseFloat(actual, 64)
+	expectedNumber, expectedErr := strconv.ParseFloat(expected, 64)
+
+	if actualErr != nil || expectedErr != nil {
+		return actual == expected
+	}
+
+	//NaN is not equal to itself, but programs that print it where it is expected are correct
+	if actualNumber == expectedNumber || math.IsNaN(actualNumber) && math.IsNaN(expectedNumber) {
+		return true
+	}
+
+	difference := math.Abs(actualNumber - expectedNumber)
+
+	return difference <= comparator.AbsoluteTolerance || difference <= comparator.RelativeTolerance*math.Abs(expectedNumber)
+}
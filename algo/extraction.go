@@ -0,0 +1,252 @@
+package algo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	. "github.com/RISElabQueens/intertrans/common"
+	. "github.com/RISElabQueens/intertrans/executor"
+)
+
+// Names of the ways of extracting the translation from the inference output, set in codeExtraction
+const (
+	RegexExtractionName  = "regex"
+	FencedExtractionName = "fenced"
+)
+
+// Strategies that found the code of an edge, recorded in its ExtractionStrategy
+const (
+	RegexStrategy        = "regex"
+	TaggedBlockStrategy  = "tagged_block"
+	LargestBlockStrategy = "largest_block"
+)
+
+// Extracts the code of the edge from the inference output. Returns the strategy that found it
+type CodeExtraction func(translationEdge *TranslationEdge, inferenceResult string) (string, string, bool)
+
+// Returns the extraction requested in codeExtraction. The regex template of the request is used by default
+func GetCodeExtraction(config *AppConfig) (CodeExtraction, error) {
+	switch config.CodeExtraction {
+	case "", RegexExtractionName:
+		return extractWithRegex, nil
+	case FencedExtractionName:
+		return ExtractFencedSourceCode, nil
+	default:
+		return nil, fmt.Errorf("code extraction %s not found", config.CodeExtraction)
+	}
+}
+
+func extractWithRegex(translationEdge *TranslationEdge, inferenceResult string) (string, string, bool) {
+	extracted, extractedOk := ExtractSourceCode(translationEdge.Prompt, translationEdge.RegexTemplate, inferenceResult)
+	return extracted, RegexStrategy, extractedOk
+}
+
+// Fence tags of the languages whose name or file extension is not the usual tag
+var fenceLanguageTags = map[string][]string{
+	"Python":     {"python3", "py3"},
+	"JavaScript": {"node", "nodejs"},
+	"C++":        {"c++", "cxx", "cc"},
+	"C#":         {"csharp", "c#"},
+	"Go":         {"golang"},
+	"Rust":       {"rs"},
+}
+
+// Fenced block of a markdown text. Info is the first word after the opening fence
+type CodeBlock struct {
+	Info       string
+	Code       string
+	Terminated bool
+}
+
+// Picks the largest block tagged with the target language of the edge, or else the largest untagged block, and
+// only then the blocks tagged with another language, which are usually the source code repeated by the model. With
+// checkExtractedSyntax, blocks that don't parse in the target language are skipped, unless none of them parses.
+// Languages without a syntax checker accept every block. Outputs without fenced blocks fall back to the regex
+// template
+func ExtractFencedSourceCode(translationEdge *TranslationEdge, inferenceResult string) (string, string, bool) {
+	blocks := responseCodeBlocks(translationEdge.Prompt, inferenceResult)
+
+	if len(blocks) == 0 {
+		return extractWithRegex(translationEdge, inferenceResult)
+	}
+
+	tagged := []CodeBlock{}
+	untagged := []CodeBlock{}
+	foreign := []CodeBlock{}
+
+	for _, block := range blocks {
+		switch {
+		case isTaggedWith(block.Info, translationEdge.TargetLanguage):
+			tagged = append(tagged, block)
+		case block.Info == "":
+			untagged = append(untagged, block)
+		default:
+			foreign = append(foreign, block)
+		}
+	}
+
+	sortLargestFirst(tagged)
+	sortLargestFirst(untagged)
+	sortLargestFirst(foreign)
+
+	type candidate struct {
+		code     string
+		strategy string
+	}
+
+	candidates := []candidate{}
+
+	for _, block := range tagged {
+		candidates = append(candidates, candidate{code: block.Code, strategy: TaggedBlockStrategy})
+	}
+
+	for _, block := range append(untagged, foreign...) {
+		candidates = append(candidates, candidate{code: block.Code, strategy: LargestBlockStrategy})
+	}
+
+	checker, hasChecker := GetSyntaxChecker(translationEdge.TargetLanguage)
+
+	if translationEdge.GetConfig().CheckExtractedSyntax && hasChecker {
+		for _, candidate := range candidates {
+			if checker.Check(candidate.code) == nil {
+				return candidate.code, candidate.strategy, true
+			}
+		}
+	}
+
+	//The tests give better feedback than a missing translation when no block parses
+	return candidates[0].code, candidates[0].strategy, true
+}
+
+// Blocks of the inference output. Unless applyRegexInferenceOnly is set, the prompt may end with an opening fence
+// that the output continues, in which case that fence opens the first block of the output
+func responseCodeBlocks(prompt string, inferenceResult string) []CodeBlock {
+	if !ConfigStore.ApplyRegexInferenceOnly {
+		promptBlocks := ParseCodeBlocks(prompt)
+
+		if len(promptBlocks) > 0 && !promptBlocks[len(promptBlocks)-1].Terminated {
+			inferenceResult = "```" + promptBlocks[len(promptBlocks)-1].Info + "\n" + inferenceResult
+		}
+	}
+
+	blocks := []CodeBlock{}
+
+	for _, block := range ParseCodeBlocks(inferenceResult) {
+		if strings.TrimSpace(block.Code) != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
+
+// Parses the blocks fenced with ``` or ~~~. A fence closes on a line made of at least as many fence characters,
+// or on a line of code that ends with the fence, which some models emit. A block left open runs to the end
+func ParseCodeBlocks(text string) []CodeBlock {
+	blocks := []CodeBlock{}
+
+	var current *CodeBlock
+	var fence string
+	var lines []string
+
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if current == nil {
+			opening := openingFence(trimmed)
+
+			if opening == "" {
+				continue
+			}
+
+			info := strings.TrimSpace(trimmed[len(opening):])
+
+			//Inline code spans such as ```x``` are not blocks
+			if strings.Contains(info, opening[:3]) {
+				continue
+			}
+
+			fields := strings.Fields(info)
+			current = &CodeBlock{}
+
+			if len(fields) > 0 {
+				current.Info = fields[0]
+			}
+
+			fence = opening
+			lines = []string{}
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			current.Code = strings.TrimSpace(strings.Join(lines, "\n"))
+			current.Terminated = true
+			blocks = append(blocks, *current)
+			current = nil
+			continue
+		}
+
+		if strings.HasSuffix(trimmed, fence) {
+			lines = append(lines, strings.TrimSuffix(strings.TrimRight(line, " \t\r"), fence))
+			current.Code = strings.TrimSpace(strings.Join(lines, "\n"))
+			current.Terminated = true
+			blocks = append(blocks, *current)
+			current = nil
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if current != nil {
+		current.Code = strings.TrimSpace(strings.Join(lines, "\n"))
+		blocks = append(blocks, *current)
+	}
+
+	return blocks
+}
+
+// Returns the run of fence characters that opens the line, or "" if the line doesn't open a block
+func openingFence(trimmedLine string) string {
+	for _, character := range []string{"`", "~"} {
+		length := len(trimmedLine) - len(strings.TrimLeft(trimmedLine, character))
+
+		if length >= 3 {
+			return trimmedLine[:length]
+		}
+	}
+
+	return ""
+}
+
+// Fences are tagged with the name of the language, its file extension or one of its usual aliases, in any case
+func isTaggedWith(info string, language string) bool {
+	tag := strings.ToLower(info)
+
+	if tag == "" {
+		return false
+	}
+
+	if tag == strings.ToLower(language) {
+		return true
+	}
+
+	if extension, exists := GetFileExtensionsMap()[language]; exists && tag == strings.ToLower(strings.TrimPrefix(extension, ".")) {
+		return true
+	}
+
+	for _, alias := range fenceLanguageTags[language] {
+		if tag == alias {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortLargestFirst(blocks []CodeBlock) {
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return len(blocks[i].Code) > len(blocks[j].Code)
+	})
+}
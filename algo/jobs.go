@@ -0,0 +1,220 @@
+package algo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/RISElabQueens/intertrans/common"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Jobs that are still running in this process. Finished jobs are only kept in the database
+var (
+	activeJobs       = make(map[string]*JobStatus)
+	activeJobCancels = make(map[string]context.CancelFunc)
+	activeJobsMutex  sync.Mutex
+)
+
+// Short id shown to the user for a batch. It is also the id of the job when submitted asynchronously
+func NewBatchId() string {
+	return uuid.New().String()[:6]
+}
+
+func newJobId() string {
+	for {
+		jobId := NewBatchId()
+
+		if _, err := LoadJobStatus(jobId); err {
+			return jobId
+		}
+	}
+}
+
+// Registers an InterTrans batch as a job and processes it in the background
+func SubmitJob(batchRequest *BatchTranslationRequest) (*JobStatus, error) {
+	ApplyBatchOverrides(batchRequest)
+
+	//A batch in which no request can run is rejected before creating the job
+	if err := ValidateTranslationBatch(batchRequest, ValidateInterTransRequest); err != nil {
+		return nil, err
+	}
+
+	activeJobsMutex.Lock()
+	defer activeJobsMutex.Unlock()
+
+	jobId := newJobId()
+	batchRequest.Id = jobId
+
+	job := &JobStatus{
+		JobId:         jobId,
+		Status:        ResponseStatus_PENDING,
+		TotalRequests: int32(len(batchRequest.TranslationRequests)),
+		SubmittedAt:   time.Now().UnixMilli(),
+	}
+
+	if err := SaveJobStatus(job); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not save job: %v", err)
+	}
+
+	// The job must outlive the gRPC call that submitted it
+	ctx, cancel := context.WithCancel(context.Background())
+	activeJobs[jobId] = job
+	activeJobCancels[jobId] = cancel
+
+	go runJob(ctx, jobId, batchRequest)
+
+	return proto.Clone(job).(*JobStatus), nil
+}
+
+func runJob(ctx context.Context, jobId string, batchRequest *BatchTranslationRequest) {
+	updateActiveJob(jobId, func(job *JobStatus) {
+		job.Status = ResponseStatus_PROCESSING
+	})
+
+	listener := &BatchListener{
+		OnTranslationResponse: func(response *TranslationResponse) {
+			updateActiveJob(jobId, func(job *JobStatus) {
+				job.CompletedRequests++
+			})
+		},
+	}
+
+	//InterTrans would only return a stub when the batch is saved to disk, but the result of the job keeps every response
+	fileBaseName, fileSavePath := batchRequest.FileBaseName, batchRequest.FileSavePath
+	batchRequest.FileBaseName, batchRequest.FileSavePath = "", ""
+
+	response := InterTrans(ctx, batchRequest, listener)
+
+	if fileBaseName != "" && fileSavePath != "" {
+		SaveBatchResponseToFile(fileBaseName, fileSavePath, response)
+	}
+
+	resultErr := SaveJobResult(jobId, response)
+
+	updateActiveJob(jobId, func(job *JobStatus) {
+		if resultErr != nil {
+			job.Status = ResponseStatus_FAILED
+			job.Error = resultErr.Error()
+		} else if job.Status != ResponseStatus_CANCELLED {
+			job.Status = ResponseStatus_DONE
+		}
+
+		job.FinishedAt = time.Now().UnixMilli()
+	})
+
+	activeJobsMutex.Lock()
+	activeJobCancels[jobId]()
+	delete(activeJobs, jobId)
+	delete(activeJobCancels, jobId)
+	activeJobsMutex.Unlock()
+}
+
+// Applies a change to a running job and persists it
+func updateActiveJob(jobId string, update func(job *JobStatus)) {
+	activeJobsMutex.Lock()
+	defer activeJobsMutex.Unlock()
+
+	job, ok := activeJobs[jobId]
+
+	if !ok {
+		return
+	}
+
+	update(job)
+
+	if err := SaveJobStatus(job); err != nil {
+		fmt.Printf("Error saving status of job %s: %v\n", jobId, err)
+	}
+}
+
+func GetJobStatus(jobId string) (*JobStatus, error) {
+	activeJobsMutex.Lock()
+	defer activeJobsMutex.Unlock()
+
+	if job, ok := activeJobs[jobId]; ok {
+		return proto.Clone(job).(*JobStatus), nil
+	}
+
+	job, err := LoadJobStatus(jobId)
+
+	if err {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", jobId)
+	}
+
+	return job, nil
+}
+
+// Results are available once the job is done. Cancelled jobs return the requests that finished before the cancellation
+func GetJobResult(jobId string) (*BatchTranslationResponse, error) {
+	job, err := GetJobStatus(jobId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	activeJobsMutex.Lock()
+	_, running := activeJobs[jobId]
+	activeJobsMutex.Unlock()
+
+	if running || (job.Status != ResponseStatus_DONE && job.Status != ResponseStatus_CANCELLED) {
+		return nil, status.Errorf(codes.FailedPrecondition, "job %s has no result yet (status %s)", jobId, job.Status)
+	}
+
+	response, notFound := LoadJobResult(jobId)
+
+	if notFound {
+		return nil, status.Errorf(codes.NotFound, "result for job %s not found", jobId)
+	}
+
+	return response, nil
+}
+
+// Stops scheduling the remaining requests of a job. Finished jobs are returned unchanged
+func CancelJob(jobId string) (*JobStatus, error) {
+	activeJobsMutex.Lock()
+	defer activeJobsMutex.Unlock()
+
+	job, ok := activeJobs[jobId]
+
+	if !ok {
+		job, err := LoadJobStatus(jobId)
+
+		if err {
+			return nil, status.Errorf(codes.NotFound, "job %s not found", jobId)
+		}
+
+		return job, nil
+	}
+
+	activeJobCancels[jobId]()
+	job.Status = ResponseStatus_CANCELLED
+
+	if err := SaveJobStatus(job); err != nil {
+		fmt.Printf("Error saving status of job %s: %v\n", jobId, err)
+	}
+
+	return proto.Clone(job).(*JobStatus), nil
+}
+
+// Jobs that were running when the server stopped can't be resumed, so they are marked as failed
+func RecoverInterruptedJobs() {
+	for _, job := range LoadAllJobStatuses() {
+
+		if job.Status != ResponseStatus_PENDING && job.Status != ResponseStatus_PROCESSING {
+			continue
+		}
+
+		job.Status = ResponseStatus_FAILED
+		job.Error = "Interrupted by a server restart"
+		job.FinishedAt = time.Now().UnixMilli()
+
+		if err := SaveJobStatus(job); err != nil {
+			fmt.Printf("Error saving status of job %s: %v\n", job.JobId, err)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package algo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/RISElabQueens/intertrans/common"
+	. "github.com/RISElabQueens/intertrans/common"
+	"github.com/google/uuid"
+	"github.com/gosuri/uiprogress"
+	"golang.org/x/sync/semaphore"
+)
+
+// Maximum number of characters of compiler, runtime or test output included in a repair prompt
+const maxErrorFeedbackLength = 4096
+
+// Baseline from Pan et al. (ICSE 2024): direct translation followed by rounds of
+// repair prompts that feed back the compilation/runtime errors and failing tests
+func PanEtAl(ctx context.Context, batchRequest *BatchTranslationRequest) *BatchTranslationResponse {
+	var wtg sync.WaitGroup
+
+	//We assign an id to the request
+	//TODO: Should be done on the client side and returned immediately for the client to see
+	uuidObj := uuid.New()
+	shortUUID := uuidObj.String()[:6]
+	batchRequest.Id = shortUUID
+
+	ApplyBatchOverrides(batchRequest)
+
+	//Keep references to preserve the order when we return the responses
+	responseChannel := make(chan *TranslationResponse, len(batchRequest.TranslationRequests))
+
+	//Initialize progress bar. Each request is a single path that grows with the repair rounds
+	totalPaths := len(batchRequest.TranslationRequests)
+
+	bar := uiprogress.AddBar(totalPaths).AppendCompleted().AppendElapsed()
+	bar.PrependFunc(func(b *uiprogress.Bar) string {
+		return fmt.Sprintf("%s (%d/%d)", shortUUID, b.Current(), totalPaths)
+	})
+
+	//TODO: This batch size is hardcoded
+	var (
+		maxBatch = 250
+		sem      = semaphore.NewWeighted(int64(maxBatch))
+	)
+
+	//Shared by the requests, which also have their own budgets
+	batchBudget := NewBudgetTracker(batchRequest.Budget, nil)
+
+	for index, request := range batchRequest.TranslationRequests {
+		//Stop scheduling new requests once the batch is cancelled
+		if err := sem.Acquire(ctx, 1); err != nil {
+			cancelUnscheduledRequests(batchRequest.TranslationRequests[index:], err, responseChannel)
+			break
+		}
+
+		//Invalid requests are answered right away so that the rest of the batch still runs
+		if err := ValidateTranslationRequest(request); err != nil {
+			sem.Release(1)
+			responseChannel <- invalidTranslationResponse(request, err)
+			bar.Incr()
+			continue
+		}
+
+		wtg.Add(1)
+		go RequestTranslationDirectorPanEtAl(ctx, request, &wtg, bar, responseChannel, sem, batchBudget)
+
+	}
+
+	wtg.Wait()
+
+	//We are not expecting more values
+	close(responseChannel)
+
+	allResponses := []*TranslationResponse{}
+
+	for response := range responseChannel {
+		allResponses = append(allResponses, response)
+	}
+
+	//Return sorted by translation id
+	sort.SliceStable(allResponses, func(i, j int) bool {
+		return allResponses[i].TranslationRequest.Id < allResponses[j].TranslationRequest.Id
+	})
+
+	response := &BatchTranslationResponse{
+		RequestId:            shortUUID,
+		TranslationResponses: allResponses,
+		Usage:                SumBatchTokenUsage(allResponses),
+		BudgetUsage:          batchBudget.ToResponse(),
+	}
+
+	if batchRequest.FileBaseName != "" && batchRequest.FileSavePath != "" {
+		//We should save to the disk instead
+		SaveBatchResponseToFile(batchRequest.FileBaseName, batchRequest.FileSavePath, response)
+		return &BatchTranslationResponse{
+			RequestId:            shortUUID,
+			TranslationResponses: []*TranslationResponse{},
+			ReturnedToDisk:       true,
+			Usage:                response.Usage,
+			BudgetUsage:          response.BudgetUsage,
+		}
+	}
+	return response
+}
+
+func RequestTranslationDirectorPanEtAl(ctx context.Context, translationRequest *TranslationRequest, wtg *sync.WaitGroup, progressbar *uiprogress.Bar, responseChannel chan *TranslationResponse, semaphore *semaphore.Weighted, batchBudget *BudgetTracker) {
+	defer wtg.Done()
+	defer semaphore.Release(1)
+
+	//The response cache is keyed by the ToCT settings, so it is not used here to avoid mixing results with InterTrans
+
+	config := RequestConfig(translationRequest)
+	promptTemplate := GetPromptTemplate(translationRequest.PromptTemplateName)
+	repairPromptTemplate := GetPromptTemplate(common.ConfigStore.PanEtAlRepairPromptTemplate)
+	regexTemplate := GetRegexTemplate(translationRequest.RegexTemplateName)
+
+	//For the Edge Id
+	counter := NewCounter()
+
+	//The repair rounds are charged to the budget of the request
+	budget := NewBudgetTracker(translationRequest.Budget, batchBudget)
+
+	path := Path{
+		FinalTarget: translationRequest.TargetLanguage,
+	}
+
+	//The first round is a direct translation of the seed code
+	edge := &TranslationEdge{
+		Id:              counter.Next(),
+		TranslationId:   translationRequest.Id,
+		InputLanguage:   translationRequest.SeedLanguage,
+		TargetLanguage:  translationRequest.TargetLanguage,
+		Level:           1,
+		ProcessingMutex: &sync.Mutex{},
+		StatusMutex:     &sync.Mutex{},
+		SourceCode:      translationRequest.SeedCode,
+		PromptTemplate:  promptTemplate,
+		FuzzyTests:      []FuzzyTest{},
+		UnitTests:       []UnitTest{},
+		RegexTemplate:   regexTemplate,
+		ModelName:       translationRequest.ModelName,
+		ExtraPromptData: translationRequest.ExtraPromptData,
+		Config:          config,
+		Budget:          budget,
+	}
+
+	//Make sure to include unit tests in the edge
+	AttachTestSuiteFromRequest(edge, translationRequest)
+
+	//Unit tests may need the target signature to be leaked to work
+	AttachTargetSignatureFromRequest(edge, translationRequest)
+
+	for round := 0; ; round++ {
+		path.Add(edge)
+		path.UsedMemoizedEdgeIndex = append(path.UsedMemoizedEdgeIndex, false)
+
+		edge.Prompt = PreparePrompt(edge)
+		PerformTranslationStep(ctx, edge, path.FinalTarget)
+
+		if round >= config.PanEtAlRepairRounds || !IsRepairableStatus(edge.GetStatus()) {
+			break
+		}
+
+		edge = NewRepairEdge(edge, repairPromptTemplate, counter, translationRequest)
+	}
+
+	progressbar.Incr()
+
+	processedChannel := make(chan Path, 1)
+	processedChannel <- path
+	close(processedChannel)
+
+	translationResponse := ConvertPathsToResponse(processedChannel, translationRequest)
+	translationResponse.BudgetUsage = budget.ToResponse()
+
+	responseChannel <- translationResponse
+}
+
+// Only translations that were executed and failed can be repaired with feedback
+func IsRepairableStatus(status Status) bool {
+	switch status {
+	case FAILED, FAILED_EXECUTION, FAILED_VERIFICATION, FAILED_EXECUTION_TIMEOUT, FAILED_SYNTAX, FAILED_COMPILATION, FAILED_RUNTIME:
+		return true
+	default:
+		return false
+	}
+}
+
+// A repair edge asks the model to fix the code of a failed edge in the same language. It keeps the level of the
+// failed edge, since it takes its place in the ToCT, and its number of attempt is kept in RepairAttempt
+func NewRepairEdge(failedEdge *TranslationEdge, repairPromptTemplate string, counter *Counter, translationRequest *TranslationRequest) *TranslationEdge {
+	repairEdge := &TranslationEdge{
+		Id:                       counter.Next(),
+		TranslationId:            failedEdge.TranslationId,
+		InputLanguage:            failedEdge.TargetLanguage,
+		TargetLanguage:           failedEdge.TargetLanguage,
+		Level:                    failedEdge.Level,
+		ParentEdge:               failedEdge,
+		ProcessingMutex:          &sync.Mutex{},
+		StatusMutex:              &sync.Mutex{},
+		SourceCode:               failedEdge.ExtractedSourceCode,
+		PromptTemplate:           repairPromptTemplate,
+		FuzzyTests:               []FuzzyTest{},
+		UnitTests:                []UnitTest{},
+		RegexTemplate:            failedEdge.RegexTemplate,
+		ModelName:                failedEdge.ModelName,
+		ExtraPromptData:          failedEdge.ExtraPromptData,
+		SuggestedTargetSignature: failedEdge.SuggestedTargetSignature,
+		ErrorFeedback:            BuildErrorFeedback(failedEdge),
+		Config:                   failedEdge.Config,
+		Budget:                   failedEdge.Budget,
+		RepairAttempt:            failedEdge.RepairAttempt + 1,
+	}
+
+	//Repaired code is verified against the same tests as the failed edge
+	AttachTestSuiteFromRequest(repairEdge, translationRequest)
+
+	return repairEdge
+}
+
+// Describes why an edge failed so that the model can fix its own translation
+func BuildErrorFeedback(edge *TranslationEdge) string {
+	if edge.GetStatus() == FAILED_SYNTAX {
+		return "Your generated code has the following syntax error:\n" + truncateFeedback(edge.ExecutionOutput)
+	}
+
+	isCompilationRuntimeError, isTestError := FindFailureReason(edge)
+
+	if isCompilationRuntimeError {
+		for _, test := range edge.FuzzyTests {
+			if !test.ExitCodeZero {
+				return executionErrorFeedback(test.ActualOutput, test.Phases)
+			}
+		}
+
+		for _, test := range edge.UnitTests {
+			if !test.ExitCodeZero {
+				return executionErrorFeedback(test.ActualOutput, test.Phases)
+			}
+		}
+	}
+
+	if isTestError {
+		for _, test := range edge.FuzzyTests {
+			if !test.Passed {
+				var feedback strings.Builder
+				feedback.WriteString("Executing your generated code gives the following test failure:\n")
+				feedback.WriteString("Input:\n" + truncateFeedback(test.Input) + "\n")
+				feedback.WriteString("Expected output:\n" + truncateFeedback(test.ExpectedOutput) + "\n")
+				feedback.WriteString("Actual output:\n" + truncateFeedback(test.ActualOutput))
+				return feedback.String()
+			}
+		}
+
+		for _, test := range edge.UnitTests {
+			if !test.Passed {
+				return "Executing your generated code gives the following test failure:\n" + truncateFeedback(test.SourceCode) + "\nOutput:\n" + truncateFeedback(test.ActualOutput)
+			}
+		}
+	}
+
+	return "Your generated code does not pass the tests."
+}
+
+func executionErrorFeedback(output string, phases ExecutionPhases) string {
+	if output == "CMD_TIMEOUT_KILLED" {
+		return "Executing your generated code did not finish within the time limit."
+	}
+
+	if phases.CompileFailed {
+		return "Compiling your generated code gives the following error:\n" + truncateFeedback(phases.CompileOutput)
+	}
+
+	if phases.Signal != "" {
+		return "Executing your generated code crashes with " + phases.Signal + ":\n" + truncateFeedback(output)
+	}
+
+	return "Executing your generated code gives the following error because it is unable to compile or run:\n" + truncateFeedback(output)
+}
+
+// Feedback ends up in prompts and responses, so it is cut on a rune boundary to keep it valid UTF-8
+func truncateFeedback(output string) string {
+	if len(output) > maxErrorFeedbackLength {
+		end := maxErrorFeedbackLength
+
+		for end > 0 && !utf8.RuneStart(output[end]) {
+			end--
+		}
+
+		return output[:end] + "\n..."
+	}
+
+	return output
+}
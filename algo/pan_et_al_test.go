@@ -0,0 +1,28 @@
+package algo
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateFeedbackKeepsValidUTF8(t *testing.T) {
+	//Compilers print localized messages and quotes with multi-byte characters
+	output := strings.Repeat("é", maxErrorFeedbackLength)
+
+	for _, prefix := range []string{"", "x"} {
+		truncated := truncateFeedback(prefix + output)
+
+		if !utf8.ValidString(truncated) {
+			t.Fatalf("truncated feedback with prefix %q is not valid UTF-8", prefix)
+		}
+
+		if !strings.HasSuffix(truncated, "\n...") || len(truncated) > maxErrorFeedbackLength+len("\n...") {
+			t.Fatalf("unexpected truncation with prefix %q: %d bytes", prefix, len(truncated))
+		}
+	}
+
+	if short := "error: ✗"; truncateFeedback(short) != short {
+		t.Fatalf("short feedback should not be truncated")
+	}
+}
@@ -0,0 +1,56 @@
+package algo
+
+import (
+	"context"
+
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Repairs the edges of the ToCT of a request that fail their tests, by sending the failing code with the feedback
+// of the tests back to the model. A nil repairer doesn't repair
+type EdgeRepairer struct {
+	Request        *TranslationRequest
+	PromptTemplate string
+	MaxAttempts    int
+	Counter        *Counter
+}
+
+// Returns nil when maxRepairAttempts is 0. The repair prompt template was validated with the request
+func NewEdgeRepairer(translationRequest *TranslationRequest, config *AppConfig, counter *Counter) *EdgeRepairer {
+	if config.MaxRepairAttempts <= 0 {
+		return nil
+	}
+
+	return &EdgeRepairer{
+		Request:        translationRequest,
+		PromptTemplate: GetPromptTemplate(config.RepairPromptTemplate),
+		MaxAttempts:    config.MaxRepairAttempts,
+		Counter:        counter,
+	}
+}
+
+// Each attempt is a child edge in the same language that repairs the previous attempt, until one of them is no
+// longer failing or the attempts run out. The edge is only failed if its last attempt failed
+func (repairer *EdgeRepairer) Repair(ctx context.Context, edge *TranslationEdge, finalPathTarget string) {
+	if repairer == nil {
+		return
+	}
+
+	current := edge
+
+	for attempt := 1; attempt <= repairer.MaxAttempts && IsRepairableStatus(current.GetStatus()); attempt++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		repairEdge := NewRepairEdge(current, repairer.PromptTemplate, repairer.Counter, repairer.Request)
+		repairEdge.OnStatusChange = edge.OnStatusChange
+
+		edge.RepairEdges = append(edge.RepairEdges, repairEdge)
+
+		repairEdge.Prompt = PreparePrompt(repairEdge)
+		PerformTranslationStep(ctx, repairEdge, finalPathTarget)
+
+		current = repairEdge
+	}
+}
@@ -0,0 +1,508 @@
+package algo
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	. "github.com/RISElabQueens/intertrans/common"
+	"github.com/gosuri/uiprogress"
+)
+
+// Decides which edges of the ToCT of a request are translated and in which order. Returns the translated paths
+type SearchStrategy interface {
+	Search(ctx context.Context, search *ToCTSearch) chan Path
+}
+
+const (
+	ExhaustiveSearchName = "exhaustive"
+	BestFirstSearchName  = "best_first"
+	BeamSearchName       = "beam"
+)
+
+// Returns the strategy of the request with its scorer. The exhaustive search is used by default
+func GetSearchStrategy(config *AppConfig) (SearchStrategy, error) {
+	scorer, err := GetEdgeScorer(config.SearchScorer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch config.SearchStrategy {
+	case "", ExhaustiveSearchName:
+		return &ExhaustiveSearch{}, nil
+	case BestFirstSearchName:
+		return &BestFirstSearch{Scorer: scorer}, nil
+	case BeamSearchName:
+		return &BeamSearch{Scorer: scorer}, nil
+	default:
+		return nil, fmt.Errorf("search strategy %s not found", config.SearchStrategy)
+	}
+}
+
+// Ranks the translated edges whose children are explored first. Returns false for edges that can't be expanded
+type EdgeScorer interface {
+	Score(edge *TranslationEdge) (float64, bool)
+}
+
+const (
+	DepthScorerName   = "depth"
+	TestsScorerName   = "tests"
+	LogProbScorerName = "logprob"
+)
+
+func GetEdgeScorer(name string) (EdgeScorer, error) {
+	switch name {
+	case "", DepthScorerName:
+		return &DepthScorer{}, nil
+	case TestsScorerName:
+		return &TestsScorer{}, nil
+	case LogProbScorerName:
+		return &LogProbScorer{}, nil
+	default:
+		return nil, fmt.Errorf("search scorer %s not found", name)
+	}
+}
+
+// Gives the same score to every translated edge, so the shallow edges are explored first
+type DepthScorer struct{}
+
+func (scorer *DepthScorer) Score(edge *TranslationEdge) (float64, bool) {
+	return 0, isTranslated(edge)
+}
+
+// Fraction of the tests passed by the intermediate translation. Translations that only pass some of the tests are
+// explored too, after the ones that pass all of them. Needs verifyIntermediateTranslations
+type TestsScorer struct{}
+
+func (scorer *TestsScorer) Score(edge *TranslationEdge) (float64, bool) {
+	switch edge.GetStatus() {
+	case SUCCESS:
+		return 1, true
+	case TRANSLATED:
+		return 0, true
+	case FAILED, FAILED_EXECUTION, FAILED_VERIFICATION, FAILED_EXECUTION_TIMEOUT, FAILED_SYNTAX, FAILED_COMPILATION, FAILED_RUNTIME:
+		passed, total := 0, len(edge.FuzzyTests)+len(edge.UnitTests)
+
+		for _, test := range edge.FuzzyTests {
+			if test.Passed {
+				passed++
+			}
+		}
+
+		for _, test := range edge.UnitTests {
+			if test.Passed {
+				passed++
+			}
+		}
+
+		if passed == 0 {
+			return 0, false
+		}
+
+		return float64(passed) / float64(total), true
+	default:
+		return 0, false
+	}
+}
+
+// Mean log-probability of the tokens of the translation, which the model returns when this scorer is used
+type LogProbScorer struct{}
+
+func (scorer *LogProbScorer) Score(edge *TranslationEdge) (float64, bool) {
+	return edge.LogProb, isTranslated(edge)
+}
+
+func isTranslated(edge *TranslationEdge) bool {
+	status := edge.GetStatus()
+	return status == SUCCESS || status == TRANSLATED
+}
+
+// Request whose ToCT is explored, with the edges created as the search goes
+type ToCTSearch struct {
+	Request        *TranslationRequest
+	Config         *AppConfig
+	PromptTemplate string
+	RegexTemplate  string
+	Budget         *BudgetTracker
+	Listener       *BatchListener
+	Progressbar    *uiprogress.Bar
+	Counter        *Counter
+	Repairer       *EdgeRepairer
+}
+
+// Creates the edges from the translation of the parent, or from the seed code for a nil parent. The last level
+// only has edges to the target language, so that every edge can still reach it
+func (search *ToCTSearch) Expand(parent *TranslationEdge) []*TranslationEdge {
+	request := search.Request
+	inputLanguage, seedCode, depth := request.SeedLanguage, request.SeedCode, 1
+
+	if parent != nil {
+		inputLanguage, seedCode, depth = parent.TargetLanguage, "", parent.Level+1
+	}
+
+	children := []*TranslationEdge{}
+
+	if depth > search.Config.ExpansionDepth {
+		return children
+	}
+
+	for _, language := range request.UsedLanguages {
+
+		if language == inputLanguage || depth == search.Config.ExpansionDepth && language != request.TargetLanguage {
+			continue
+		}
+
+		candidates := search.Config.GetCandidatesPerEdge()
+		var sampleGroup *SampleGroup
+
+		if candidates > 1 {
+			sampleGroup = NewSampleGroup(candidates)
+		}
+
+		for sampleIndex := 0; sampleIndex < candidates; sampleIndex++ {
+			edge := NewTreeEdge(request, search.Config, search.PromptTemplate, search.RegexTemplate, inputLanguage, language, seedCode, depth, parent, search.Counter, sampleGroup, sampleIndex)
+			edge.Budget = search.Budget
+			search.Listener.attachToEdge(edge)
+
+			children = append(children, edge)
+		}
+	}
+
+	return children
+}
+
+// Translates the edges at once, or one after the other in compute efficient mode
+func (search *ToCTSearch) Explore(ctx context.Context, edges []*TranslationEdge) {
+	wg := sync.WaitGroup{}
+
+	for _, edge := range edges {
+		if search.Config.ComputeEfficientMode {
+			search.exploreEdge(ctx, edge)
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(edge *TranslationEdge) {
+			defer wg.Done()
+			search.exploreEdge(ctx, edge)
+		}(edge)
+	}
+
+	wg.Wait()
+}
+
+func (search *ToCTSearch) exploreEdge(ctx context.Context, edge *TranslationEdge) {
+	if ctx.Err() != nil {
+		edge.SetStatus(CANCELLED)
+		return
+	}
+
+	if edge.Budget.Exhausted() != "" && !edge.SampleGroup.Started() {
+		edge.SetStatus(BUDGET_EXHAUSTED)
+		return
+	}
+
+	if edge.ParentEdge != nil {
+		edge.SourceCode = edge.ParentEdge.Resolved().ExtractedSourceCode
+	}
+
+	edge.Prompt = PreparePrompt(edge)
+	PerformTranslationStep(ctx, edge, search.Request.TargetLanguage)
+	search.Repairer.Repair(ctx, edge, search.Request.TargetLanguage)
+}
+
+// Edges to the target language end their path, so they are never expanded. Repaired edges are scored by their last
+// repair attempt
+func (search *ToCTSearch) score(scorer EdgeScorer, edge *TranslationEdge) (float64, bool) {
+	if edge.TargetLanguage == search.Request.TargetLanguage {
+		return 0, false
+	}
+
+	return scorer.Score(edge.Resolved())
+}
+
+// Checking the budget latches it as exhausted, so it is checked last and only when there is work left, otherwise a
+// search that already found its translation would be reported as stopped by the budget
+func (search *ToCTSearch) shouldStop(ctx context.Context, explored []*TranslationEdge) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+
+	if search.Config.EarlyStopOnTranslationSuccess {
+		for _, edge := range explored {
+			if edge.Resolved().GetStatus() == TRANSLATION_FOUND {
+				return true
+			}
+		}
+	}
+
+	return search.Budget.Exhausted() != ""
+}
+
+// Every explored edge without explored children ends a path. Edges already returned in a previous path are marked
+// as memoized, like the edges shared between the paths of the exhaustive search
+func (search *ToCTSearch) Paths(explored []*TranslationEdge) chan Path {
+	expanded := make(map[*TranslationEdge]bool)
+
+	for _, edge := range explored {
+		if edge.ParentEdge != nil {
+			expanded[edge.ParentEdge] = true
+		}
+	}
+
+	paths := []Path{}
+	returned := make(map[*TranslationEdge]bool)
+
+	for _, edge := range explored {
+		if expanded[edge] {
+			continue
+		}
+
+		edges := []*TranslationEdge{}
+
+		for current := edge; current != nil; current = current.ParentEdge {
+			edges = append([]*TranslationEdge{current}, edges...)
+		}
+
+		path := Path{
+			FinalTarget: search.Request.TargetLanguage,
+		}
+
+		for _, pathEdge := range edges {
+			path.Add(pathEdge)
+			path.UsedMemoizedEdgeIndex = append(path.UsedMemoizedEdgeIndex, returned[pathEdge])
+			returned[pathEdge] = true
+		}
+
+		paths = append(paths, path)
+	}
+
+	processedChannel := make(chan Path, len(paths))
+
+	for _, path := range paths {
+		processedChannel <- path
+	}
+	close(processedChannel)
+
+	return processedChannel
+}
+
+// The progress bar counts the paths of the whole ToCT, which the lazy strategies don't build
+func (search *ToCTSearch) finishProgress() {
+	request := search.Request
+	totalPaths, _ := CountIntermediatesTranslationTree(request.UsedLanguages, request.SeedLanguage, request.TargetLanguage, search.Config.ExpansionDepth, search.Config.GetCandidatesPerEdge())
+
+	for i := 0; i < totalPaths; i++ {
+		search.Progressbar.Incr()
+	}
+}
+
+// Builds every path of the ToCT up front and translates them concurrently, sharing the common prefixes
+type ExhaustiveSearch struct{}
+
+func (strategy *ExhaustiveSearch) Search(ctx context.Context, search *ToCTSearch) chan Path {
+	request := search.Request
+
+	initialPath := &Path{
+		FinalTarget: request.TargetLanguage,
+	}
+
+	translationPaths := &TranslationPaths{
+		Paths: []Path{},
+	}
+
+	BuildIntermediatesTranslationTree(request, search.Config, search.PromptTemplate, search.RegexTemplate, request.UsedLanguages, request.SeedLanguage, request.TargetLanguage, request.SeedCode, 1, search.Config.ExpansionDepth, nil, initialPath, translationPaths, search.Counter)
+
+	allPaths := translationPaths.Paths
+
+	//Sort them to prioritize translations to the request target. The ordering was validated with the request
+	orderPaths, _ := GetPathOrdering(search.Config)
+	orderPaths(allPaths, request)
+
+	//Stream the edges as they are processed if someone is listening
+	search.Listener.attachToEdges(allPaths)
+
+	attachBudget(allPaths, search.Budget)
+
+	processedChannel := make(chan Path, len(allPaths))
+
+	wg := sync.WaitGroup{}
+
+	for _, path := range allPaths {
+		wg.Add(1)
+
+		//Disable concurrent branch processing for compute saving mode
+		if search.Config.ComputeEfficientMode {
+			processTranslationPath(ctx, path, allPaths, processedChannel, search.Progressbar, &wg, search.Repairer)
+		} else {
+			go processTranslationPath(ctx, path, allPaths, processedChannel, search.Progressbar, &wg, search.Repairer)
+		}
+
+	}
+
+	// Wait for path translation goroutines to finish
+	wg.Wait()
+
+	//All goroutines ended, so we are not expecting new values
+	close(processedChannel)
+
+	return processedChannel
+}
+
+// Translates the best edges of the frontier, searchWidth at a time, and adds their children to the frontier
+type BestFirstSearch struct {
+	Scorer EdgeScorer
+}
+
+func (strategy *BestFirstSearch) Search(ctx context.Context, search *ToCTSearch) chan Path {
+	frontier := &edgeFrontier{finalTarget: search.Request.TargetLanguage}
+	frontier.pushAll(search.Expand(nil), math.Inf(1))
+
+	explored := []*TranslationEdge{}
+
+	for frontier.Len() > 0 && !search.shouldStop(ctx, explored) {
+		batch := frontier.popBest(search.Config.GetSearchWidth())
+
+		search.Explore(ctx, batch)
+		explored = append(explored, batch...)
+
+		for _, edge := range batch {
+			if score, expandable := search.score(strategy.Scorer, edge); expandable {
+				frontier.pushAll(search.Expand(edge), score)
+			}
+		}
+	}
+
+	search.finishProgress()
+
+	return search.Paths(explored)
+}
+
+// Translates the children of the edges in the beam one level at a time, and keeps the searchWidth best of them
+// as the next beam
+type BeamSearch struct {
+	Scorer EdgeScorer
+}
+
+func (strategy *BeamSearch) Search(ctx context.Context, search *ToCTSearch) chan Path {
+	explored := []*TranslationEdge{}
+
+	//The seed code is the only node of the first beam
+	beam := []*TranslationEdge{nil}
+
+	for len(beam) > 0 && !search.shouldStop(ctx, explored) {
+		level := []*TranslationEdge{}
+
+		for _, parent := range beam {
+			level = append(level, search.Expand(parent)...)
+		}
+
+		search.Explore(ctx, level)
+		explored = append(explored, level...)
+
+		beam = strategy.selectBeam(search, level)
+	}
+
+	search.finishProgress()
+
+	return search.Paths(explored)
+}
+
+func (strategy *BeamSearch) selectBeam(search *ToCTSearch, level []*TranslationEdge) []*TranslationEdge {
+	type scoredEdge struct {
+		edge  *TranslationEdge
+		score float64
+	}
+
+	scored := []scoredEdge{}
+
+	for _, edge := range level {
+		if score, expandable := search.score(strategy.Scorer, edge); expandable {
+			scored = append(scored, scoredEdge{edge: edge, score: score})
+		}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+
+	beam := []*TranslationEdge{}
+
+	for index := 0; index < len(scored) && index < search.Config.GetSearchWidth(); index++ {
+		beam = append(beam, scored[index].edge)
+	}
+
+	return beam
+}
+
+// Edges waiting to be translated, ordered by the score of their parent. Ties go to shallow edges, then to edges
+// that reach the target language, then to the oldest ones
+type edgeFrontier struct {
+	finalTarget string
+	items       []frontierItem
+}
+
+type frontierItem struct {
+	edge  *TranslationEdge
+	score float64
+}
+
+func (frontier *edgeFrontier) Len() int {
+	return len(frontier.items)
+}
+
+func (frontier *edgeFrontier) Less(i, j int) bool {
+	a, b := frontier.items[i], frontier.items[j]
+
+	if a.score != b.score {
+		return a.score > b.score
+	}
+
+	if a.edge.Level != b.edge.Level {
+		return a.edge.Level < b.edge.Level
+	}
+
+	aReachesTarget := a.edge.TargetLanguage == frontier.finalTarget
+	bReachesTarget := b.edge.TargetLanguage == frontier.finalTarget
+
+	if aReachesTarget != bReachesTarget {
+		return aReachesTarget
+	}
+
+	return a.edge.Id < b.edge.Id
+}
+
+func (frontier *edgeFrontier) Swap(i, j int) {
+	frontier.items[i], frontier.items[j] = frontier.items[j], frontier.items[i]
+}
+
+func (frontier *edgeFrontier) Push(item any) {
+	frontier.items = append(frontier.items, item.(frontierItem))
+}
+
+func (frontier *edgeFrontier) Pop() any {
+	last := frontier.items[len(frontier.items)-1]
+	frontier.items = frontier.items[:len(frontier.items)-1]
+	return last
+}
+
+func (frontier *edgeFrontier) pushAll(edges []*TranslationEdge, score float64) {
+	for _, edge := range edges {
+		heap.Push(frontier, frontierItem{edge: edge, score: score})
+	}
+}
+
+func (frontier *edgeFrontier) popBest(count int) []*TranslationEdge {
+	edges := []*TranslationEdge{}
+
+	for len(edges) < count && frontier.Len() > 0 {
+		edges = append(edges, heap.Pop(frontier).(frontierItem).edge)
+	}
+
+	return edges
+}
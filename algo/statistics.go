@@ -0,0 +1,215 @@
+package algo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	. "github.com/RISElabQueens/intertrans/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Names of the orders in which the exhaustive search schedules the paths of the ToCT
+const (
+	ShallowFirstOrderingName = "shallow_first"
+	HistoryOrderingName      = "history"
+)
+
+// Sorts the paths of the ToCT of a request before they are scheduled
+type PathOrdering func(allPaths []Path, translationRequest *TranslationRequest)
+
+// Returns the ordering requested in pathOrdering. Shallow paths go first by default
+func GetPathOrdering(config *AppConfig) (PathOrdering, error) {
+	switch config.PathOrdering {
+	case "", ShallowFirstOrderingName:
+		return func(allPaths []Path, translationRequest *TranslationRequest) {
+			PrioritizeShallowFirst(allPaths)
+		}, nil
+	case HistoryOrderingName:
+		return PrioritizeHistoricalBest, nil
+	default:
+		return nil, fmt.Errorf("path ordering %s not found", config.PathOrdering)
+	}
+}
+
+// Brings the paths whose languages found the most translations for the model and target language in previous
+// requests to the front. Paths with the same success rate keep the shallow first order
+func PrioritizeHistoricalBest(allPaths []Path, translationRequest *TranslationRequest) {
+	statistics := LoadTargetStatistics(translationRequest.ModelName, translationRequest.TargetLanguage)
+
+	type scoredPath struct {
+		path  Path
+		score float64
+	}
+
+	scored := []scoredPath{}
+
+	for _, path := range allPaths {
+		//Paths without statistics get the rate of a sequence that helped as often as it failed
+		score := 0.5
+
+		if len(path.Edges) > 0 {
+			if pathStatistics, exists := statistics[strings.Join(pathLanguages(path.Edges), ">")]; exists {
+				score = pathStatistics.SmoothedSuccessRate()
+			}
+		}
+
+		scored = append(scored, scoredPath{path: path, score: score})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return len(scored[i].path.Edges) < len(scored[j].path.Edges)
+	})
+
+	for index := range scored {
+		allPaths[index] = scored[index].path
+	}
+}
+
+// Seed language followed by the target language of each edge
+func pathLanguages(edges []*TranslationEdge) []string {
+	languages := []string{edges[0].InputLanguage}
+
+	for _, edge := range edges {
+		languages = append(languages, edge.TargetLanguage)
+	}
+
+	return languages
+}
+
+// Outcome of every sequence of languages that the paths of the response went through. A sequence succeeds if any
+// path through it found a translation. Paths that were skipped, cancelled or stopped by a budget before finding a
+// translation or failing don't count
+func PathOutcomes(response *TranslationResponse) []PathOutcome {
+	outcomes := []PathOutcome{}
+	indexes := make(map[string]int)
+
+	for _, path := range response.Paths {
+		edges := []*TranslationEdge{}
+		resolvedEdges := []*TranslationEdge{}
+
+		//A repaired edge is decided by its last repair attempt, which is in the same language
+		for _, responseEdge := range path.TranslationEdges {
+			edge := ConvertFromEdgeResponse(responseEdge)
+
+			if edge.RepairAttempt > 0 && len(resolvedEdges) > 0 {
+				resolvedEdges[len(resolvedEdges)-1] = edge
+				continue
+			}
+
+			edges = append(edges, edge)
+			resolvedEdges = append(resolvedEdges, edge)
+		}
+
+		decidedEdges, success, failureCategory := pathResult(resolvedEdges)
+
+		//Every sequence up to the edge that decided the path shares its outcome
+		for end := 1; end <= decidedEdges; end++ {
+			languages := pathLanguages(edges[:end])
+			key := strings.Join(languages, ">")
+
+			index, exists := indexes[key]
+
+			if !exists {
+				indexes[key] = len(outcomes)
+				outcomes = append(outcomes, PathOutcome{Languages: languages, Success: success, FailureCategory: failureCategory})
+				continue
+			}
+
+			if success && !outcomes[index].Success {
+				outcomes[index].Success = true
+				outcomes[index].FailureCategory = ""
+			}
+		}
+	}
+
+	return outcomes
+}
+
+// Returns the number of edges up to the one that found a translation or failed, and 0 for undecided paths
+func pathResult(edges []*TranslationEdge) (int, bool, string) {
+	for index, edge := range edges {
+		switch edge.GetStatus() {
+		case SUCCESS, TRANSLATED:
+			continue
+		case TRANSLATION_FOUND:
+			return index + 1, true, ""
+		case FAILED_NO_INFERENCE:
+			return index + 1, false, InferenceFailure
+		case FAILED_NO_EXTRACTED:
+			return index + 1, false, ExtractionFailure
+		case FAILED_EXECUTION_TIMEOUT:
+			return index + 1, false, TimeoutFailure
+		case FAILED_EXECUTION, FAILED_SYNTAX, FAILED_COMPILATION, FAILED_RUNTIME:
+			return index + 1, false, CompilationRuntimeFailure
+		case FAILED_VERIFICATION:
+			return index + 1, false, TestFailure
+		case FAILED:
+			return index + 1, false, executionFailureCategory(edge)
+		default:
+			return 0, false, ""
+		}
+	}
+
+	return 0, false, ""
+}
+
+// The status of an edge is the first failure of its tests, except for FAILED, which doesn't tell whether the code
+// crashed or gave a wrong result
+func executionFailureCategory(edge *TranslationEdge) string {
+	if isCompilationRuntimeError, _ := FindFailureReason(edge); isCompilationRuntimeError {
+		return CompilationRuntimeFailure
+	}
+
+	return TestFailure
+}
+
+// Adds the outcomes of a finished request to the language statistics
+func RecordStatistics(response *TranslationResponse) {
+	request := response.TranslationRequest
+
+	if err := RecordLanguageStatistics(request.ModelName, request.TargetLanguage, PathOutcomes(response)); err != nil {
+		fmt.Printf("Error saving language statistics: %v\n", err)
+	}
+}
+
+// Counts the responses that were cached before the statistics existed. It only runs once per database
+func BackfillStatistics() {
+	if StatisticsBackfilled() {
+		return
+	}
+
+	counted := 0
+
+	err := ForEachCachedResponse(func(response *TranslationResponse) {
+		RecordStatistics(response)
+		counted++
+	})
+
+	if err != nil {
+		fmt.Printf("Error reading cached responses for the language statistics: %v\n", err)
+		return
+	}
+
+	if err := MarkStatisticsBackfilled(); err != nil {
+		fmt.Printf("Error saving language statistics: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Info: Language statistics computed from %d cached responses.\n", counted)
+}
+
+// Statistics recorded for the model, seed and target language set in the request. Unset fields match everything
+func GetLanguageStatistics(request *LanguageStatisticsRequest) (*LanguageStatisticsResponse, error) {
+	statistics, err := LoadLanguageStatistics(request)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not read language statistics: %v", err)
+	}
+
+	return &LanguageStatisticsResponse{Statistics: statistics}, nil
+}
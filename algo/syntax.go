@@ -0,0 +1,58 @@
+package algo
+
+import (
+	"context"
+
+	. "github.com/RISElabQueens/intertrans/common"
+	. "github.com/RISElabQueens/intertrans/executor"
+)
+
+// Exit codes of the checker command that don't tell anything about the code: the runner could not run it (-1), the
+// container didn't start (125), the command is not executable or not installed (126 and 127), or it was killed by a
+// signal (above 128)
+func isCheckerFailure(exitCode int) bool {
+	return exitCode < 0 || exitCode >= 125
+}
+
+// Checks the syntax of the translation of an edge before its tests are executed. Languages with a syntax checker
+// are parsed in the engine, and the others run the syntaxCheck command of their container, if it has one. The
+// error is kept in the ExecutionOutput of the edge. Returns false as second value if the request was cancelled
+func PerformSyntaxCheck(ctx context.Context, translationEdge *TranslationEdge) (bool, bool) {
+	if checker, exists := GetSyntaxChecker(translationEdge.TargetLanguage); exists {
+		if err := checker.Check(translationEdge.ExtractedSourceCode); err != nil {
+			translationEdge.ExecutionOutput = err.Error()
+			return false, true
+		}
+
+		return true, true
+	}
+
+	if GetExecutorForLanguageMap()[translationEdge.TargetLanguage].SyntaxCheck == "" {
+		return true, true
+	}
+
+	executionUnit := &ExecutionUnit{
+		SourceCode:    translationEdge.ExtractedSourceCode,
+		Language:      translationEdge.TargetLanguage,
+		OutputChannel: make(chan ExecutionUnit),
+		ExecutionType: SYNTAX_CHECK,
+	}
+
+	executionResult, ok := submitExecution(ctx, executionUnit)
+
+	if !ok {
+		return false, false
+	}
+
+	//The check runs in the container like a test, so it is charged as an execution
+	translationEdge.Budget.RecordExecution()
+
+	//A checker that is too slow or could not run doesn't tell anything about the code, so the tests decide
+	if executionResult.Success || executionResult.ExecutionOutput == "CMD_TIMEOUT_KILLED" || isCheckerFailure(executionResult.ExitCode) {
+		return true, true
+	}
+
+	translationEdge.ExecutionOutput = executionResult.ExecutionOutput
+
+	return false, true
+}
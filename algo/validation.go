@@ -0,0 +1,350 @@
+package algo
+
+import (
+	"fmt"
+	"regexp"
+
+	. "github.com/RISElabQueens/intertrans/common"
+	. "github.com/RISElabQueens/intertrans/executor"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Checks a request before any work is scheduled for it, so that a client mistake only fails that request
+// instead of crashing the server
+func ValidateTranslationRequest(request *TranslationRequest) error {
+	if request.SeedLanguage == "" || request.TargetLanguage == "" {
+		return fmt.Errorf("seed_language and target_language are required")
+	}
+
+	if _, exists := ConfigStore.PromptTemplates[request.PromptTemplateName]; !exists {
+		return fmt.Errorf("prompt template %q not found", request.PromptTemplateName)
+	}
+
+	regexTemplate, exists := ConfigStore.RegexTemplates[request.RegexTemplateName]
+
+	if !exists {
+		return fmt.Errorf("regex template %q not found", request.RegexTemplateName)
+	}
+
+	if _, err := regexp.Compile(regexTemplate); err != nil {
+		return fmt.Errorf("invalid regex template %q: %w", request.RegexTemplateName, err)
+	}
+
+	if err := validateTestSuite(request.TestSuite); err != nil {
+		return err
+	}
+
+	if err := validateOverrides(request.Overrides); err != nil {
+		return err
+	}
+
+	if _, err := GetCodeExtraction(RequestConfig(request)); err != nil {
+		return err
+	}
+
+	if err := validateBudget(request.Budget); err != nil {
+		return err
+	}
+
+	if _, err := GetInferenceProvider(request.ModelName); err != nil {
+		return err
+	}
+
+	if len(ModelEndpoints(request.ModelName)) == 0 {
+		return fmt.Errorf("no inference endpoint serves model %s", request.ModelName)
+	}
+
+	//Deeper trees can translate back into the seed language from an intermediate language
+	seedIsTarget := RequestConfig(request).ExpansionDepth != 1
+
+	//Only the target language is executed, unless the intermediate translations are verified too
+	executedLanguages := []string{request.TargetLanguage}
+
+	if RequestConfig(request).VerifyIntermediateTranslations {
+		for _, language := range request.UsedLanguages {
+			if (language != request.SeedLanguage || seedIsTarget) && language != request.TargetLanguage {
+				executedLanguages = append(executedLanguages, language)
+			}
+		}
+	}
+
+	for _, language := range executedLanguages {
+		if err := CheckLanguageSupport(language); err != nil {
+			return err
+		}
+
+		if err := validateUnitTestsForLanguage(request.TestSuite, language); err != nil {
+			return err
+		}
+	}
+
+	if ConfigStore.UseTranscoderTestFormat {
+		for _, language := range append([]string{request.TargetLanguage}, request.UsedLanguages...) {
+			if _, exists := commentSeparators[language]; !exists && (language != request.SeedLanguage || seedIsTarget) {
+				return fmt.Errorf("comment separator for %s not supported", language)
+			}
+		}
+	}
+
+	return nil
+}
+
+// The ToCT only generates paths that end in the target language when it is one of the used languages
+func ValidateInterTransRequest(request *TranslationRequest) error {
+	if err := ValidateTranslationRequest(request); err != nil {
+		return err
+	}
+
+	config := RequestConfig(request)
+
+	if err := validateSearch(config); err != nil {
+		return err
+	}
+
+	//Failed edges are only repaired when maxRepairAttempts is set
+	if _, exists := ConfigStore.PromptTemplates[config.RepairPromptTemplate]; config.MaxRepairAttempts > 0 && !exists {
+		return fmt.Errorf("repair prompt template %q not found", config.RepairPromptTemplate)
+	}
+
+	for _, language := range request.UsedLanguages {
+		if language == request.TargetLanguage {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("target language %s must be one of the used languages", request.TargetLanguage)
+}
+
+// Checks the search strategy, scorer and path ordering. The tests scorer ranks the intermediate translations by
+// the tests they pass, so they must be executed
+func validateSearch(config *AppConfig) error {
+	if _, err := GetSearchStrategy(config); err != nil {
+		return err
+	}
+
+	if config.SearchScorer == TestsScorerName && !config.VerifyIntermediateTranslations {
+		return fmt.Errorf("search scorer %s needs verifyIntermediateTranslations", TestsScorerName)
+	}
+
+	_, err := GetPathOrdering(config)
+	return err
+}
+
+// CA@k samples the direct translation several times, so the request must not use intermediate translations nor a seed
+func ValidateDirectCAKRequest(request *TranslationRequest) error {
+	if err := ValidateTranslationRequest(request); err != nil {
+		return err
+	}
+
+	config := RequestConfig(request)
+
+	if config.ExpansionDepth != 1 {
+		return fmt.Errorf("CA@k needs only direct translations, set the expansion depth to 1")
+	}
+
+	if config.Seed != -1 {
+		return fmt.Errorf("CA@k needs the seed to be disabled, set the seed to -1")
+	}
+
+	return nil
+}
+
+func validateOverrides(overrides *ConfigOverrides) error {
+	if overrides == nil {
+		return nil
+	}
+
+	if overrides.ExpansionDepth != nil && overrides.ExpansionDepth.Value < 1 {
+		return fmt.Errorf("expansion_depth override must be at least 1")
+	}
+
+	if overrides.MaxGeneratedTokens != nil && overrides.MaxGeneratedTokens.Value < 1 {
+		return fmt.Errorf("max_generated_tokens override must be at least 1")
+	}
+
+	if overrides.Temperature != nil && overrides.Temperature.Value < 0 {
+		return fmt.Errorf("temperature override must not be negative")
+	}
+
+	if overrides.TopP != nil && (overrides.TopP.Value <= 0 || overrides.TopP.Value > 1) {
+		return fmt.Errorf("top_p override must be in (0, 1]")
+	}
+
+	if overrides.PanEtAlRepairRounds != nil && overrides.PanEtAlRepairRounds.Value < 0 {
+		return fmt.Errorf("pan_et_al_repair_rounds override must not be negative")
+	}
+
+	if overrides.CandidatesPerEdge != nil && overrides.CandidatesPerEdge.Value < 1 {
+		return fmt.Errorf("candidates_per_edge override must be at least 1")
+	}
+
+	if overrides.SearchWidth != nil && overrides.SearchWidth.Value < 1 {
+		return fmt.Errorf("search_width override must be at least 1")
+	}
+
+	if overrides.MaxRepairAttempts != nil && overrides.MaxRepairAttempts.Value < 0 {
+		return fmt.Errorf("max_repair_attempts override must not be negative")
+	}
+
+	return nil
+}
+
+// Zero values have no limit
+func validateBudget(budget *Budget) error {
+	if budget.GetMaxInferences() < 0 || budget.GetMaxGeneratedTokens() < 0 || budget.GetMaxExecutions() < 0 || budget.GetMaxWallTimeMs() < 0 {
+		return fmt.Errorf("budget limits must not be negative")
+	}
+
+	return nil
+}
+
+func ValidateVerificationRequest(request *VerificationRequest) error {
+	if request.TargetLanguage == "" {
+		return fmt.Errorf("target_language is required")
+	}
+
+	if err := validateTestSuite(request.TestSuite); err != nil {
+		return err
+	}
+
+	if err := CheckLanguageSupport(request.TargetLanguage); err != nil {
+		return err
+	}
+
+	return validateUnitTestsForLanguage(request.TestSuite, request.TargetLanguage)
+}
+
+func validateTestSuite(testSuite *TestSuite) error {
+	if testSuite == nil || len(testSuite.UnitTestSuite) == 0 && len(testSuite.FuzzySuite) == 0 {
+		return fmt.Errorf("test_suite must contain fuzzy or unit tests")
+	}
+
+	for index, test := range testSuite.FuzzySuite {
+		if err := ValidateFuzzyComparator(ResolveFuzzyComparator(testSuite, test), test.ExpectedOutput); err != nil {
+			return fmt.Errorf("fuzzy test %d: %w", index, err)
+		}
+	}
+
+	return nil
+}
+
+// Unit tests are written for a specific language. Languages without them can only be verified with the fuzzy tests
+// of a mixed suite
+func validateUnitTestsForLanguage(testSuite *TestSuite, language string) error {
+	if len(testSuite.UnitTestSuite) == 0 {
+		return nil
+	}
+
+	hasUnitTests := false
+
+	for _, test := range testSuite.UnitTestSuite {
+		if test.Language != language {
+			continue
+		}
+
+		hasUnitTests = true
+
+		//Tests without imports are filled with the function extracted from the translation
+		if ConfigStore.UseTranscoderTestFormat && test.Imports == "" && !transcoderTestLanguages[language] {
+			return fmt.Errorf("language %s not supported by the TransCoder test format", language)
+		}
+	}
+
+	if !hasUnitTests && len(testSuite.FuzzySuite) == 0 {
+		return fmt.Errorf("unit tests for %s not found. If you use unit tests, you must include test cases for all verified languages", language)
+	}
+
+	return nil
+}
+
+// Returns nil if at least one request of the batch is valid, so that the valid ones still run and the invalid ones
+// are answered with their error. Otherwise the batch is rejected with InvalidArgument and an error detail per request
+func ValidateBatch(field string, requestCount int, validate func(index int) error) error {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	for index := 0; index < requestCount; index++ {
+		if err := validate(index); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("%s[%d]", field, index),
+				Description: err.Error(),
+			})
+		}
+	}
+
+	if len(violations) == 0 || len(violations) < requestCount {
+		return nil
+	}
+
+	invalid := status.New(codes.InvalidArgument, fmt.Sprintf("all %d requests of the batch are invalid", requestCount))
+	detailed, err := invalid.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+
+	if err != nil {
+		return invalid.Err()
+	}
+
+	return detailed.Err()
+}
+
+func ValidateTranslationBatch(batchRequest *BatchTranslationRequest, validate func(request *TranslationRequest) error) error {
+	if err := validateBudget(batchRequest.Budget); err != nil {
+		return status.Errorf(codes.InvalidArgument, "batch %v", err)
+	}
+
+	return ValidateBatch("translation_requests", len(batchRequest.TranslationRequests), func(index int) error {
+		return validate(batchRequest.TranslationRequests[index])
+	})
+}
+
+func ValidateVerificationBatch(batchRequest *BatchVerificationRequest) error {
+	return ValidateBatch("verification_requests", len(batchRequest.VerificationRequests), func(index int) error {
+		return ValidateVerificationRequest(batchRequest.VerificationRequests[index])
+	})
+}
+
+// The inference cache would return the same sample every time
+func CheckDirectCAKConfig() error {
+	if ConfigStore.UseInferenceCache {
+		return status.Error(codes.FailedPrecondition, "inference cache must not be used for CA@k")
+	}
+
+	return nil
+}
+
+func CheckPanEtAlConfig() error {
+	if _, exists := ConfigStore.PromptTemplates[ConfigStore.PanEtAlRepairPromptTemplate]; !exists {
+		return status.Errorf(codes.FailedPrecondition, "repair prompt template %q not found", ConfigStore.PanEtAlRepairPromptTemplate)
+	}
+
+	return nil
+}
+
+func CheckVerificationConfig() error {
+	if !ConfigStore.ApplyRegexInferenceOnly {
+		return status.Error(codes.FailedPrecondition, "we only support extracting from inference output at this time, set applyRegexInferenceOnly to true")
+	}
+
+	if _, exists := ConfigStore.RegexTemplates[verificationRegexTemplate]; !exists {
+		return status.Errorf(codes.FailedPrecondition, "regex template %q not found", verificationRegexTemplate)
+	}
+
+	return nil
+}
+
+// Answers the requests that were not scheduled because the batch was cancelled, so that the batch still has a
+// response for each of its requests
+func cancelUnscheduledRequests(requests []*TranslationRequest, err error, responseChannel chan *TranslationResponse) {
+	for _, request := range requests {
+		responseChannel <- invalidTranslationResponse(request, fmt.Errorf("request cancelled before it was scheduled: %w", err))
+	}
+}
+
+// Responses of requests that were not processed, such as invalid requests, with the reason in their error
+func invalidTranslationResponse(request *TranslationRequest, err error) *TranslationResponse {
+	return &TranslationResponse{
+		TranslationRequest: request,
+		Paths:              []*ResponseTranslationPath{},
+		Error:              err.Error(),
+	}
+}
@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0cprotos.proto\"X\n\tTestSuite\x12#\n\x0b\x66uzzy_suite\x18\x01 \x03(\x0b\x32\x0e.FuzzyTestCase\x12&\n\x0funit_test_suite\x18\x02 \x03(\x0b\x32\r.UnitTestCase\"=\n\rFuzzyTestCase\x12\x13\n\x0bstdin_input\x18\x01 \x01(\t\x12\x17\n\x0f\x65xpected_output\x18\x02 \x01(\t\"\x83\x01\n\x15ResponseFuzzyTestCase\x12\x13\n\x0bstdin_input\x18\x01 \x01(\t\x12\x17\n\x0f\x65xpected_output\x18\x02 \x01(\t\x12\x15\n\ractual_output\x18\x03 \x01(\t\x12\x0e\n\x06passed\x18\x04 \x01(\x08\x12\x15\n\rexecuted_code\x18\x05 \x01(\t\"i\n\x14ResponseUnitTestCase\x12\x13\n\x0bsource_code\x18\x01 \x01(\t\x12\x15\n\ractual_output\x18\x02 \x01(\t\x12\x0e\n\x06passed\x18\x03 \x01(\x08\x12\x15\n\rexecuted_code\x18\x04 \x01(\t\"D\n\x0cUnitTestCase\x12\x10\n\x08language\x18\x01 \x01(\t\x12\x11\n\ttest_case\x18\x02 \x01(\t\x12\x0f\n\x07imports\x18\x03 \x01(\t\"6\n\x0fTargetSignature\x12\x10\n\x08language\x18\x01 \x01(\t\x12\x11\n\tsignature\x18\x02 \x01(\t\"\xb2\x02\n\x12TranslationRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x15\n\rseed_language\x18\x02 \x01(\t\x12\x17\n\x0ftarget_language\x18\x03 \x01(\t\x12\x11\n\tseed_code\x18\x04 \x01(\t\x12\x1e\n\ntest_suite\x18\x05 \x01(\x0b\x32\n.TestSuite\x12\x16\n\x0eused_languages\x18\x06 \x03(\t\x12\x1c\n\x14prompt_template_name\x18\x07 \x01(\t\x12+\n\x11target_signatures\x18\x08 \x03(\x0b\x32\x10.TargetSignature\x12\x1b\n\x13regex_template_name\x18\t \x01(\t\x12\x12\n\nmodel_name\x18\n \x01(\t\x12\x19\n\x11\x65xtra_prompt_data\x18\x0b \x01(\t\"\x93\x04\n\x17ResponseTranslationEdge\x12\x17\n\x0fprompt_template\x18\x01 \x01(\t\x12\x0e\n\x06prompt\x18\x02 \x01(\t\x12\x16\n\x0etranslation_id\x18\x03 \x01(\t\x12\x16\n\x0einput_language\x18\x04 \x01(\t\x12\x17\n\x0ftarget_language\x18\x05 \x01(\t\x12\r\n\x05level\x18\x06 \x01(\x05\x12\x0f\n\x07success\x18\x07 \x01(\x08\x12\x18\n\x10inference_output\x18\x08 \x01(\t\x12\x18\n\x10\x65xecution_output\x18\t \x01(\t\x12\x13\n\x0bsource_code\x18\n \x01(\t\x12\x1d\n\x15\x65xtracted_source_code\x18\x0b \x01(\t\x12\x16\n\x0eparent_edge_id\x18\x0c \x01(\x05\x12\x0e\n\x06status\x18\r \x01(\t\x12+\n\x0b\x66uzzy_tests\x18\x0e \x03(\x0b\x32\x16.ResponseFuzzyTestCase\x12)\n\nunit_tests\x18\x0f \x03(\x0b\x32\x15.ResponseUnitTestCase\x12\x0f\n\x07\x65\x64ge_id\x18\x10 \x01(\x05\x12\x19\n\x11wallTimeInference\x18\x11 \x01(\x03\x12\x1d\n\x15wallTimeTestExecution\x18\x12 \x01(\x03\x12\x17\n\x0fusedMemoization\x18\x13 \x01(\x08\x12\x1a\n\x12usedInferenceCache\x18\x14 \x01(\x08\"k\n\x17ResponseTranslationPath\x12\x33\n\x11translation_edges\x18\x01 \x03(\x0b\x32\x18.ResponseTranslationEdge\x12\x1b\n\x13\x65\x64ge_index_memoized\x18\x02 \x03(\x08\"p\n\x13TranslationResponse\x12\x30\n\x13translation_request\x18\x01 \x01(\x0b\x32\x13.TranslationRequest\x12\'\n\x05paths\x18\x02 \x03(\x0b\x32\x18.ResponseTranslationPath\"\x88\x01\n\x17\x42\x61tchTranslationRequest\x12\x31\n\x14translation_requests\x18\x01 \x03(\x0b\x32\x13.TranslationRequest\x12\n\n\x02id\x18\x02 \x01(\t\x12\x16\n\x0e\x66ile_base_name\x18\x03 \x01(\t\x12\x16\n\x0e\x66ile_save_path\x18\x04 \x01(\t\"{\n\x18\x42\x61tchTranslationResponse\x12\x33\n\x15translation_responses\x18\x01 \x03(\x0b\x32\x14.TranslationResponse\x12\x12\n\nrequest_id\x18\x02 \x01(\t\x12\x16\n\x0ereturnedToDisk\x18\x03 \x01(\x08\"|\n\x14StartEndpointRequest\x12\x12\n\nmodel_name\x18\x01 \x01(\t\x12\x0e\n\x06gpu_id\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\t\x12\x0c\n\x04seed\x18\x04 \x01(\x03\x12\x11\n\tapi_token\x18\x05 \x01(\t\x12\x11\n\tlora_path\x18\x06 \x01(\t\"(\n\x13StopEndpointRequest\x12\x11\n\tlaunch_id\x18\x01 \x01(\x03\"#\n\x0eLaunchResponse\x12\x11\n\tlaunch_id\x18\x01 \x01(\x03\"\x8a\x01\n\x13VerificationRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x1e\n\ntest_suite\x18\x02 \x01(\x0b\x32\n.TestSuite\x12\x17\n\x0finferenceOutput\x18\x03 \x01(\t\x12\x16\n\x0etargetLanguage\x18\x04 \x01(\t\x12\x16\n\x0esourceLanguage\x18\x05 \x01(\t\"\xb2\x01\n\x14VerificationResponse\x12\x32\n\x14verification_request\x18\x01 \x01(\x0b\x32\x14.VerificationRequest\x12+\n\x0b\x66uzzy_tests\x18\x02 \x03(\x0b\x32\x16.ResponseFuzzyTestCase\x12)\n\nunit_tests\x18\x03 \x03(\x0b\x32\x15.ResponseUnitTestCase\x12\x0e\n\x06status\x18\x06 \x01(\t\"[\n\x18\x42\x61tchVerificationRequest\x12\x33\n\x15verification_requests\x18\x01 \x03(\x0b\x32\x14.VerificationRequest\x12\n\n\x02id\x18\x02 \x01(\t\"\x87\x01\n\x19\x42\x61tchVerificationResponse\x12\x33\n\x15verification_requests\x18\x01 \x01(\x0b\x32\x14.VerificationRequest\x12\x35\n\x16verification_responses\x18\x02 \x03(\x0b\x32\x15.VerificationResponse*\x94\x01\n\x0eResponseStatus\x12\x0b\n\x07PENDING\x10\x00\x12\x0e\n\nPROCESSING\x10\x01\x12\n\n\x06\x46\x41ILED\x10\x02\x12\x08\n\x04\x44ONE\x10\x03\x12\x15\n\x11TRANSLATION_FOUND\x10\x04\x12\x19\n\x15SKIPPED_PARENT_FAILED\x10\x05\x12\x1d\n\x19SKIPPED_TRANSLATION_FOUND\x10\x06\x32\xc2\x02\n\x12TranslationService\x12\x45\n\x0e\x42\x61tchTranslate\x12\x18.BatchTranslationRequest\x1a\x19.BatchTranslationResponse\x12H\n\x11\x42\x61tchTranslateCAK\x12\x18.BatchTranslationRequest\x1a\x19.BatchTranslationResponse\x12L\n\x15\x42\x61tchPanEtAlTranslate\x12\x18.BatchTranslationRequest\x1a\x19.BatchTranslationResponse\x12M\n\x14\x42\x61tchRunVerification\x12\x19.BatchVerificationRequest\x1a\x1a.BatchVerificationResponse2\x9a\x01\n\x15InfrastructureService\x12\x41\n\x17LaunchInferenceEndpoint\x12\x15.StartEndpointRequest\x1a\x0f.LaunchResponse\x12>\n\x15StopInferenceEndpoint\x12\x14.StopEndpointRequest\x1a\x0f.LaunchResponseB\x0bZ\t../commonb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0cprotos.proto\"\x85\x01\n\tTestSuite\x12#\n\x0b\x66uzzy_suite\x18\x01 \x03(\x0b\x32\x0e.FuzzyTestCase\x12&\n\x0funit_test_suite\x18\x02 \x03(\x0b\x32\r.UnitTestCase\x12+\n\x10\x66uzzy_comparator\x18\x03 \x01(\x0b\x32\x11.OutputComparator\"X\n\x10OutputComparator\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x1a\n\x12\x61\x62solute_tolerance\x18\x02 \x01(\x01\x12\x1a\n\x12relative_tolerance\x18\x03 \x01(\x01\"d\n\rFuzzyTestCase\x12\x13\n\x0bstdin_input\x18\x01 \x01(\t\x12\x17\n\x0f\x65xpected_output\x18\x02 \x01(\t\x12%\n\ncomparator\x18\x03 \x01(\x0b\x32\x11.OutputComparator\"\xbd\x02\n\x15ResponseFuzzyTestCase\x12\x13\n\x0bstdin_input\x18\x01 \x01(\t\x12\x17\n\x0f\x65xpected_output\x18\x02 \x01(\t\x12\x15\n\ractual_output\x18\x03 \x01(\t\x12\x0e\n\x06passed\x18\x04 \x01(\x08\x12\x15\n\rexecuted_code\x18\x05 \x01(\t\x12\x12\n\ncomparator\x18\x06 \x01(\t\x12\x16\n\x0e\x65xit_code_zero\x18\x07 \x01(\x08\x12(\n\x06phases\x18\x08 \x01(\x0b\x32\x18.ResponseExecutionPhases\x12\x0e\n\x06stdout\x18\t \x01(\t\x12\x0e\n\x06stderr\x18\n \x01(\t\x12\x11\n\texit_code\x18\x0b \x01(\x05\x12\x14\n\x0cwall_time_ms\x18\x0c \x01(\x03\x12\x19\n\x11peak_memory_bytes\x18\r \x01(\x03\"\x8f\x02\n\x14ResponseUnitTestCase\x12\x13\n\x0bsource_code\x18\x01 \x01(\t\x12\x15\n\ractual_output\x18\x02 \x01(\t\x12\x0e\n\x06passed\x18\x03 \x01(\x08\x12\x15\n\rexecuted_code\x18\x04 \x01(\t\x12\x16\n\x0e\x65xit_code_zero\x18\x05 \x01(\x08\x12(\n\x06phases\x18\x06 \x01(\x0b\x32\x18.ResponseExecutionPhases\x12\x0e\n\x06stdout\x18\x07 \x01(\t\x12\x0e\n\x06stderr\x18\x08 \x01(\t\x12\x11\n\texit_code\x18\t \x01(\x05\x12\x14\n\x0cwall_time_ms\x18\n \x01(\x03\x12\x19\n\x11peak_memory_bytes\x18\x0b \x01(\x03\"\xb0\x01\n\x17ResponseExecutionPhases\x12\x16\n\x0e\x63ompile_failed\x18\x01 \x01(\x08\x12\x16\n\x0e\x63ompile_output\x18\x02 \x01(\t\x12\x0f\n\x07started\x18\x03 \x01(\x08\x12\x19\n\x11runtime_exit_code\x18\x04 \x01(\x05\x12\x0e\n\x06signal\x18\x05 \x01(\t\x12\x16\n\x0eruntime_stderr\x18\x06 \x01(\t\x12\x11\n\ttimed_out\x18\x07 \x01(\x08\"D\n\x0cUnitTestCase\x12\x10\n\x08language\x18\x01 \x01(\t\x12\x11\n\ttest_case\x18\x02 \x01(\t\x12\x0f\n\x07imports\x18\x03 \x01(\t\"6\n\x0fTargetSignature\x12\x10\n\x08language\x18\x01 \x01(\t\x12\x11\n\tsignature\x18\x02 \x01(\t\"\xf0\x02\n\x12TranslationRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x15\n\rseed_language\x18\x02 \x01(\t\x12\x17\n\x0ftarget_language\x18\x03 \x01(\t\x12\x11\n\tseed_code\x18\x04 \x01(\t\x12\x1e\n\ntest_suite\x18\x05 \x01(\x0b\x32\n.TestSuite\x12\x16\n\x0eused_languages\x18\x06 \x03(\t\x12\x1c\n\x14prompt_template_name\x18\x07 \x01(\t\x12+\n\x11target_signatures\x18\x08 \x03(\x0b\x32\x10.TargetSignature\x12\x1b\n\x13regex_template_name\x18\t \x01(\t\x12\x12\n\nmodel_name\x18\n \x01(\t\x12\x19\n\x11\x65xtra_prompt_data\x18\x0b \x01(\t\x12#\n\toverrides\x18\x0c \x01(\x0b\x32\x10.ConfigOverrides\x12\x17\n\x06\x62udget\x18\r \x01(\x0b\x32\x07.Budget\"p\n\x06\x42udget\x12\x16\n\x0emax_inferences\x18\x01 \x01(\x05\x12\x1c\n\x14max_generated_tokens\x18\x02 \x01(\x03\x12\x16\n\x0emax_executions\x18\x03 \x01(\x05\x12\x18\n\x10max_wall_time_ms\x18\x04 \x01(\x03\"\x1d\n\x0c\x42oolOverride\x12\r\n\x05value\x18\x01 \x01(\x08\"\x1e\n\rInt32Override\x12\r\n\x05value\x18\x01 \x01(\x05\"\x1e\n\rFloatOverride\x12\r\n\x05value\x18\x01 \x01(\x01\"\xa1\x06\n\x0f\x43onfigOverrides\x12\'\n\x0f\x65xpansion_depth\x18\x01 \x01(\x0b\x32\x0e.Int32Override\x12!\n\nearly_stop\x18\x02 \x01(\x0b\x32\r.BoolOverride\x12\x37\n verify_intermediate_translations\x18\x03 \x01(\x0b\x32\r.BoolOverride\x12-\n\x16\x63ompute_efficient_mode\x18\x04 \x01(\x0b\x32\r.BoolOverride\x12,\n\x14max_generated_tokens\x18\x05 \x01(\x0b\x32\x0e.Int32Override\x12#\n\x0btemperature\x18\x06 \x01(\x0b\x32\x0e.FloatOverride\x12\x1d\n\x05top_p\x18\x07 \x01(\x0b\x32\x0e.FloatOverride\x12\x1d\n\x05top_k\x18\x08 \x01(\x0b\x32\x0e.Int32Override\x12\x1c\n\x04seed\x18\t \x01(\x0b\x32\x0e.Int32Override\x12/\n\x17pan_et_al_repair_rounds\x18\n \x01(\x0b\x32\x0e.Int32Override\x12+\n\x13\x63\x61ndidates_per_edge\x18\x0b \x01(\x0b\x32\x0e.Int32Override\x12(\n\x0fsearch_strategy\x18\x0c \x01(\x0b\x32\x0f.StringOverride\x12&\n\rsearch_scorer\x18\r \x01(\x0b\x32\x0f.StringOverride\x12$\n\x0csearch_width\x18\x0e \x01(\x0b\x32\x0e.Int32Override\x12&\n\rpath_ordering\x18\x0f \x01(\x0b\x32\x0f.StringOverride\x12+\n\x13max_repair_attempts\x18\x10 \x01(\x0b\x32\x0e.Int32Override\x12(\n\x0f\x63ode_extraction\x18\x11 \x01(\x0b\x32\x0f.StringOverride\x12-\n\x16\x63heck_extracted_syntax\x18\x12 \x01(\x0b\x32\r.BoolOverride\x12\'\n\x10syntax_pre_check\x18\x13 \x01(\x0b\x32\r.BoolOverride\"\x1f\n\x0eStringOverride\x12\r\n\x05value\x18\x01 \x01(\t\"\xb7\x05\n\x17ResponseTranslationEdge\x12\x17\n\x0fprompt_template\x18\x01 \x01(\t\x12\x0e\n\x06prompt\x18\x02 \x01(\t\x12\x16\n\x0etranslation_id\x18\x03 \x01(\t\x12\x16\n\x0einput_language\x18\x04 \x01(\t\x12\x17\n\x0ftarget_language\x18\x05 \x01(\t\x12\r\n\x05level\x18\x06 \x01(\x05\x12\x0f\n\x07success\x18\x07 \x01(\x08\x12\x18\n\x10inference_output\x18\x08 \x01(\t\x12\x18\n\x10\x65xecution_output\x18\t \x01(\t\x12\x13\n\x0bsource_code\x18\n \x01(\t\x12\x1d\n\x15\x65xtracted_source_code\x18\x0b \x01(\t\x12\x16\n\x0eparent_edge_id\x18\x0c \x01(\x05\x12\x0e\n\x06status\x18\r \x01(\t\x12+\n\x0b\x66uzzy_tests\x18\x0e \x03(\x0b\x32\x16.ResponseFuzzyTestCase\x12)\n\nunit_tests\x18\x0f \x03(\x0b\x32\x15.ResponseUnitTestCase\x12\x0f\n\x07\x65\x64ge_id\x18\x10 \x01(\x05\x12\x19\n\x11wallTimeInference\x18\x11 \x01(\x03\x12\x1d\n\x15wallTimeTestExecution\x18\x12 \x01(\x03\x12\x17\n\x0fusedMemoization\x18\x13 \x01(\x08\x12\x1a\n\x12usedInferenceCache\x18\x14 \x01(\x08\x12\x1e\n\x16\x66\x61iled_test_categories\x18\x15 \x03(\t\x12\x17\n\x0f\x63\x61ndidate_index\x18\x16 \x01(\x05\x12\"\n\x05usage\x18\x17 \x01(\x0b\x32\x13.ResponseTokenUsage\x12\x10\n\x08log_prob\x18\x18 \x01(\x01\x12\x16\n\x0erepair_attempt\x18\x19 \x01(\x05\x12\x1b\n\x13\x65xtraction_strategy\x18\x1a \x01(\t\"^\n\x12ResponseTokenUsage\x12\x15\n\rprompt_tokens\x18\x01 \x01(\x03\x12\x19\n\x11\x63ompletion_tokens\x18\x02 \x01(\x03\x12\x16\n\x0e\x65stimated_cost\x18\x03 \x01(\x01\"\x87\x01\n\x13ResponseBudgetUsage\x12\x12\n\ninferences\x18\x01 \x01(\x05\x12\x18\n\x10generated_tokens\x18\x02 \x01(\x03\x12\x12\n\nexecutions\x18\x03 \x01(\x05\x12\x14\n\x0cwall_time_ms\x18\x04 \x01(\x03\x12\x18\n\x10\x65xhausted_budget\x18\x05 \x01(\t\"k\n\x17ResponseTranslationPath\x12\x33\n\x11translation_edges\x18\x01 \x03(\x0b\x32\x18.ResponseTranslationEdge\x12\x1b\n\x13\x65\x64ge_index_memoized\x18\x02 \x03(\x08\"\xcf\x01\n\x13TranslationResponse\x12\x30\n\x13translation_request\x18\x01 \x01(\x0b\x32\x13.TranslationRequest\x12\'\n\x05paths\x18\x02 \x03(\x0b\x32\x18.ResponseTranslationPath\x12\r\n\x05\x65rror\x18\x03 \x01(\t\x12\"\n\x05usage\x18\x04 \x01(\x0b\x32\x13.ResponseTokenUsage\x12*\n\x0c\x62udget_usage\x18\x05 \x01(\x0b\x32\x14.ResponseBudgetUsage\"\xc6\x01\n\x17\x42\x61tchTranslationRequest\x12\x31\n\x14translation_requests\x18\x01 \x03(\x0b\x32\x13.TranslationRequest\x12\n\n\x02id\x18\x02 \x01(\t\x12\x16\n\x0e\x66ile_base_name\x18\x03 \x01(\t\x12\x16\n\x0e\x66ile_save_path\x18\x04 \x01(\t\x12#\n\toverrides\x18\x05 \x01(\x0b\x32\x10.ConfigOverrides\x12\x17\n\x06\x62udget\x18\x06 \x01(\x0b\x32\x07.Budget\"\xcb\x01\n\x18\x42\x61tchTranslationResponse\x12\x33\n\x15translation_responses\x18\x01 \x03(\x0b\x32\x14.TranslationResponse\x12\x12\n\nrequest_id\x18\x02 \x01(\t\x12\x16\n\x0ereturnedToDisk\x18\x03 \x01(\x08\x12\"\n\x05usage\x18\x04 \x01(\x0b\x32\x13.ResponseTokenUsage\x12*\n\x0c\x62udget_usage\x18\x05 \x01(\x0b\x32\x14.ResponseBudgetUsage\"\x82\x01\n\x10TranslationEvent\x12\x12\n\nrequest_id\x18\x01 \x01(\t\x12&\n\x04\x65\x64ge\x18\x02 \x01(\x0b\x32\x18.ResponseTranslationEdge\x12\x32\n\x14translation_response\x18\x03 \x01(\x0b\x32\x14.TranslationResponse\"_\n\x19LanguageStatisticsRequest\x12\x12\n\nmodel_name\x18\x01 \x01(\t\x12\x15\n\rseed_language\x18\x02 \x01(\t\x12\x17\n\x0ftarget_language\x18\x03 \x01(\t\"\xa3\x02\n\x16LanguagePathStatistics\x12\x12\n\nmodel_name\x18\x01 \x01(\t\x12\x17\n\x0ftarget_language\x18\x02 \x01(\t\x12\x11\n\tlanguages\x18\x03 \x03(\t\x12\x10\n\x08\x61ttempts\x18\x04 \x01(\x03\x12\x11\n\tsuccesses\x18\x05 \x01(\x03\x12\x1a\n\x12inference_failures\x18\x06 \x01(\x03\x12\x1b\n\x13\x65xtraction_failures\x18\x07 \x01(\x03\x12$\n\x1c\x63ompilation_runtime_failures\x18\x08 \x01(\x03\x12\x15\n\rtest_failures\x18\t \x01(\x03\x12\x18\n\x10timeout_failures\x18\n \x01(\x03\x12\x14\n\x0csuccess_rate\x18\x0b \x01(\x02\"I\n\x1aLanguageStatisticsResponse\x12+\n\nstatistics\x18\x01 \x03(\x0b\x32\x17.LanguagePathStatistics\"\x1c\n\nJobRequest\x12\x0e\n\x06job_id\x18\x01 \x01(\t\"\xaa\x01\n\tJobStatus\x12\x0e\n\x06job_id\x18\x01 \x01(\t\x12\x1f\n\x06status\x18\x02 \x01(\x0e\x32\x0f.ResponseStatus\x12\x16\n\x0etotal_requests\x18\x03 \x01(\x05\x12\x1a\n\x12\x63ompleted_requests\x18\x04 \x01(\x05\x12\r\n\x05\x65rror\x18\x05 \x01(\t\x12\x14\n\x0csubmitted_at\x18\x06 \x01(\x03\x12\x13\n\x0b\x66inished_at\x18\x07 \x01(\x03\"|\n\x14StartEndpointRequest\x12\x12\n\nmodel_name\x18\x01 \x01(\t\x12\x0e\n\x06gpu_id\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\t\x12\x0c\n\x04seed\x18\x04 \x01(\x03\x12\x11\n\tapi_token\x18\x05 \x01(\t\x12\x11\n\tlora_path\x18\x06 \x01(\t\"(\n\x13StopEndpointRequest\x12\x11\n\tlaunch_id\x18\x01 \x01(\x03\"#\n\x0eLaunchResponse\x12\x11\n\tlaunch_id\x18\x01 \x01(\x03\"\x8a\x01\n\x13VerificationRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x1e\n\ntest_suite\x18\x02 \x01(\x0b\x32\n.TestSuite\x12\x17\n\x0finferenceOutput\x18\x03 \x01(\t\x12\x16\n\x0etargetLanguage\x18\x04 \x01(\t\x12\x16\n\x0esourceLanguage\x18\x05 \x01(\t\"\xe1\x01\n\x14VerificationResponse\x12\x32\n\x14verification_request\x18\x01 \x01(\x0b\x32\x14.VerificationRequest\x12+\n\x0b\x66uzzy_tests\x18\x02 \x03(\x0b\x32\x16.ResponseFuzzyTestCase\x12)\n\nunit_tests\x18\x03 \x03(\x0b\x32\x15.ResponseUnitTestCase\x12\x0e\n\x06status\x18\x06 \x01(\t\x12\x1e\n\x16\x66\x61iled_test_categories\x18\x07 \x03(\t\x12\r\n\x05\x65rror\x18\x08 \x01(\t\"[\n\x18\x42\x61tchVerificationRequest\x12\x33\n\x15verification_requests\x18\x01 \x03(\x0b\x32\x14.VerificationRequest\x12\n\n\x02id\x18\x02 \x01(\t\"\x87\x01\n\x19\x42\x61tchVerificationResponse\x12\x33\n\x15verification_requests\x18\x01 \x01(\x0b\x32\x14.VerificationRequest\x12\x35\n\x16verification_responses\x18\x02 \x03(\x0b\x32\x15.VerificationResponse*\xa3\x01\n\x0eResponseStatus\x12\x0b\n\x07PENDING\x10\x00\x12\x0e\n\nPROCESSING\x10\x01\x12\n\n\x06\x46\x41ILED\x10\x02\x12\x08\n\x04\x44ONE\x10\x03\x12\x15\n\x11TRANSLATION_FOUND\x10\x04\x12\x19\n\x15SKIPPED_PARENT_FAILED\x10\x05\x12\x1d\n\x19SKIPPED_TRANSLATION_FOUND\x10\x06\x12\r\n\tCANCELLED\x10\x07\x32\xdb\x03\n\x12TranslationService\x12\x45\n\x0e\x42\x61tchTranslate\x12\x18.BatchTranslationRequest\x1a\x19.BatchTranslationResponse\x12\x45\n\x14\x42\x61tchTranslateStream\x12\x18.BatchTranslationRequest\x1a\x11.TranslationEvent0\x01\x12H\n\x11\x42\x61tchTranslateCAK\x12\x18.BatchTranslationRequest\x1a\x19.BatchTranslationResponse\x12L\n\x15\x42\x61tchPanEtAlTranslate\x12\x18.BatchTranslationRequest\x1a\x19.BatchTranslationResponse\x12M\n\x14\x42\x61tchRunVerification\x12\x19.BatchVerificationRequest\x1a\x1a.BatchVerificationResponse\x12P\n\x15GetLanguageStatistics\x12\x1a.LanguageStatisticsRequest\x1a\x1b.LanguageStatisticsResponse2\xc8\x01\n\nJobService\x12\x33\n\x0bSubmitBatch\x12\x18.BatchTranslationRequest\x1a\n.JobStatus\x12\'\n\x0cGetJobStatus\x12\x0b.JobRequest\x1a\n.JobStatus\x12\x36\n\x0cGetJobResult\x12\x0b.JobRequest\x1a\x19.BatchTranslationResponse\x12$\n\tCancelJob\x12\x0b.JobRequest\x1a\n.JobStatus2\x9a\x01\n\x15InfrastructureService\x12\x41\n\x17LaunchInferenceEndpoint\x12\x15.StartEndpointRequest\x1a\x0f.LaunchResponse\x12>\n\x15StopInferenceEndpoint\x12\x14.StopEndpointRequest\x1a\x0f.LaunchResponseB\x0bZ\t../commonb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -22,48 +22,82 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'protos_pb2', _globals)
 if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['DESCRIPTOR']._options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z\t../common'
-  _globals['_RESPONSESTATUS']._serialized_start=2625
-  _globals['_RESPONSESTATUS']._serialized_end=2773
-  _globals['_TESTSUITE']._serialized_start=16
-  _globals['_TESTSUITE']._serialized_end=104
-  _globals['_FUZZYTESTCASE']._serialized_start=106
-  _globals['_FUZZYTESTCASE']._serialized_end=167
-  _globals['_RESPONSEFUZZYTESTCASE']._serialized_start=170
-  _globals['_RESPONSEFUZZYTESTCASE']._serialized_end=301
-  _globals['_RESPONSEUNITTESTCASE']._serialized_start=303
-  _globals['_RESPONSEUNITTESTCASE']._serialized_end=408
-  _globals['_UNITTESTCASE']._serialized_start=410
-  _globals['_UNITTESTCASE']._serialized_end=478
-  _globals['_TARGETSIGNATURE']._serialized_start=480
-  _globals['_TARGETSIGNATURE']._serialized_end=534
-  _globals['_TRANSLATIONREQUEST']._serialized_start=537
-  _globals['_TRANSLATIONREQUEST']._serialized_end=843
-  _globals['_RESPONSETRANSLATIONEDGE']._serialized_start=846
-  _globals['_RESPONSETRANSLATIONEDGE']._serialized_end=1377
-  _globals['_RESPONSETRANSLATIONPATH']._serialized_start=1379
-  _globals['_RESPONSETRANSLATIONPATH']._serialized_end=1486
-  _globals['_TRANSLATIONRESPONSE']._serialized_start=1488
-  _globals['_TRANSLATIONRESPONSE']._serialized_end=1600
-  _globals['_BATCHTRANSLATIONREQUEST']._serialized_start=1603
-  _globals['_BATCHTRANSLATIONREQUEST']._serialized_end=1739
-  _globals['_BATCHTRANSLATIONRESPONSE']._serialized_start=1741
-  _globals['_BATCHTRANSLATIONRESPONSE']._serialized_end=1864
-  _globals['_STARTENDPOINTREQUEST']._serialized_start=1866
-  _globals['_STARTENDPOINTREQUEST']._serialized_end=1990
-  _globals['_STOPENDPOINTREQUEST']._serialized_start=1992
-  _globals['_STOPENDPOINTREQUEST']._serialized_end=2032
-  _globals['_LAUNCHRESPONSE']._serialized_start=2034
-  _globals['_LAUNCHRESPONSE']._serialized_end=2069
-  _globals['_VERIFICATIONREQUEST']._serialized_start=2072
-  _globals['_VERIFICATIONREQUEST']._serialized_end=2210
-  _globals['_VERIFICATIONRESPONSE']._serialized_start=2213
-  _globals['_VERIFICATIONRESPONSE']._serialized_end=2391
-  _globals['_BATCHVERIFICATIONREQUEST']._serialized_start=2393
-  _globals['_BATCHVERIFICATIONREQUEST']._serialized_end=2484
-  _globals['_BATCHVERIFICATIONRESPONSE']._serialized_start=2487
-  _globals['_BATCHVERIFICATIONRESPONSE']._serialized_end=2622
-  _globals['_TRANSLATIONSERVICE']._serialized_start=2776
-  _globals['_TRANSLATIONSERVICE']._serialized_end=3098
-  _globals['_INFRASTRUCTURESERVICE']._serialized_start=3101
-  _globals['_INFRASTRUCTURESERVICE']._serialized_end=3255
+  _globals['_RESPONSESTATUS']._serialized_start=5926
+  _globals['_RESPONSESTATUS']._serialized_end=6089
+  _globals['_TESTSUITE']._serialized_start=17
+  _globals['_TESTSUITE']._serialized_end=150
+  _globals['_OUTPUTCOMPARATOR']._serialized_start=152
+  _globals['_OUTPUTCOMPARATOR']._serialized_end=240
+  _globals['_FUZZYTESTCASE']._serialized_start=242
+  _globals['_FUZZYTESTCASE']._serialized_end=342
+  _globals['_RESPONSEFUZZYTESTCASE']._serialized_start=345
+  _globals['_RESPONSEFUZZYTESTCASE']._serialized_end=662
+  _globals['_RESPONSEUNITTESTCASE']._serialized_start=665
+  _globals['_RESPONSEUNITTESTCASE']._serialized_end=936
+  _globals['_RESPONSEEXECUTIONPHASES']._serialized_start=939
+  _globals['_RESPONSEEXECUTIONPHASES']._serialized_end=1115
+  _globals['_UNITTESTCASE']._serialized_start=1117
+  _globals['_UNITTESTCASE']._serialized_end=1185
+  _globals['_TARGETSIGNATURE']._serialized_start=1187
+  _globals['_TARGETSIGNATURE']._serialized_end=1241
+  _globals['_TRANSLATIONREQUEST']._serialized_start=1244
+  _globals['_TRANSLATIONREQUEST']._serialized_end=1612
+  _globals['_BUDGET']._serialized_start=1614
+  _globals['_BUDGET']._serialized_end=1726
+  _globals['_BOOLOVERRIDE']._serialized_start=1728
+  _globals['_BOOLOVERRIDE']._serialized_end=1757
+  _globals['_INT32OVERRIDE']._serialized_start=1759
+  _globals['_INT32OVERRIDE']._serialized_end=1789
+  _globals['_FLOATOVERRIDE']._serialized_start=1791
+  _globals['_FLOATOVERRIDE']._serialized_end=1821
+  _globals['_CONFIGOVERRIDES']._serialized_start=1824
+  _globals['_CONFIGOVERRIDES']._serialized_end=2625
+  _globals['_STRINGOVERRIDE']._serialized_start=2627
+  _globals['_STRINGOVERRIDE']._serialized_end=2658
+  _globals['_RESPONSETRANSLATIONEDGE']._serialized_start=2661
+  _globals['_RESPONSETRANSLATIONEDGE']._serialized_end=3356
+  _globals['_RESPONSETOKENUSAGE']._serialized_start=3358
+  _globals['_RESPONSETOKENUSAGE']._serialized_end=3452
+  _globals['_RESPONSEBUDGETUSAGE']._serialized_start=3455
+  _globals['_RESPONSEBUDGETUSAGE']._serialized_end=3590
+  _globals['_RESPONSETRANSLATIONPATH']._serialized_start=3592
+  _globals['_RESPONSETRANSLATIONPATH']._serialized_end=3699
+  _globals['_TRANSLATIONRESPONSE']._serialized_start=3702
+  _globals['_TRANSLATIONRESPONSE']._serialized_end=3909
+  _globals['_BATCHTRANSLATIONREQUEST']._serialized_start=3912
+  _globals['_BATCHTRANSLATIONREQUEST']._serialized_end=4110
+  _globals['_BATCHTRANSLATIONRESPONSE']._serialized_start=4113
+  _globals['_BATCHTRANSLATIONRESPONSE']._serialized_end=4316
+  _globals['_TRANSLATIONEVENT']._serialized_start=4319
+  _globals['_TRANSLATIONEVENT']._serialized_end=4449
+  _globals['_LANGUAGESTATISTICSREQUEST']._serialized_start=4451
+  _globals['_LANGUAGESTATISTICSREQUEST']._serialized_end=4546
+  _globals['_LANGUAGEPATHSTATISTICS']._serialized_start=4549
+  _globals['_LANGUAGEPATHSTATISTICS']._serialized_end=4840
+  _globals['_LANGUAGESTATISTICSRESPONSE']._serialized_start=4842
+  _globals['_LANGUAGESTATISTICSRESPONSE']._serialized_end=4915
+  _globals['_JOBREQUEST']._serialized_start=4917
+  _globals['_JOBREQUEST']._serialized_end=4945
+  _globals['_JOBSTATUS']._serialized_start=4948
+  _globals['_JOBSTATUS']._serialized_end=5118
+  _globals['_STARTENDPOINTREQUEST']._serialized_start=5120
+  _globals['_STARTENDPOINTREQUEST']._serialized_end=5244
+  _globals['_STOPENDPOINTREQUEST']._serialized_start=5246
+  _globals['_STOPENDPOINTREQUEST']._serialized_end=5286
+  _globals['_LAUNCHRESPONSE']._serialized_start=5288
+  _globals['_LAUNCHRESPONSE']._serialized_end=5323
+  _globals['_VERIFICATIONREQUEST']._serialized_start=5326
+  _globals['_VERIFICATIONREQUEST']._serialized_end=5464
+  _globals['_VERIFICATIONRESPONSE']._serialized_start=5467
+  _globals['_VERIFICATIONRESPONSE']._serialized_end=5692
+  _globals['_BATCHVERIFICATIONREQUEST']._serialized_start=5694
+  _globals['_BATCHVERIFICATIONREQUEST']._serialized_end=5785
+  _globals['_BATCHVERIFICATIONRESPONSE']._serialized_start=5788
+  _globals['_BATCHVERIFICATIONRESPONSE']._serialized_end=5923
+  _globals['_TRANSLATIONSERVICE']._serialized_start=6092
+  _globals['_TRANSLATIONSERVICE']._serialized_end=6567
+  _globals['_JOBSERVICE']._serialized_start=6570
+  _globals['_JOBSERVICE']._serialized_end=6770
+  _globals['_INFRASTRUCTURESERVICE']._serialized_start=6773
+  _globals['_INFRASTRUCTURESERVICE']._serialized_end=6927
 # @@protoc_insertion_point(module_scope)
@@ -15,6 +15,7 @@ class ResponseStatus(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     TRANSLATION_FOUND: _ClassVar[ResponseStatus]
     SKIPPED_PARENT_FAILED: _ClassVar[ResponseStatus]
     SKIPPED_TRANSLATION_FOUND: _ClassVar[ResponseStatus]
+    CANCELLED: _ClassVar[ResponseStatus]
 PENDING: ResponseStatus
 PROCESSING: ResponseStatus
 FAILED: ResponseStatus
@@ -22,48 +23,111 @@ DONE: ResponseStatus
 TRANSLATION_FOUND: ResponseStatus
 SKIPPED_PARENT_FAILED: ResponseStatus
 SKIPPED_TRANSLATION_FOUND: ResponseStatus
+CANCELLED: ResponseStatus
 
 class TestSuite(_message.Message):
-    __slots__ = ("fuzzy_suite", "unit_test_suite")
+    __slots__ = ("fuzzy_suite", "unit_test_suite", "fuzzy_comparator")
     FUZZY_SUITE_FIELD_NUMBER: _ClassVar[int]
     UNIT_TEST_SUITE_FIELD_NUMBER: _ClassVar[int]
+    FUZZY_COMPARATOR_FIELD_NUMBER: _ClassVar[int]
     fuzzy_suite: _containers.RepeatedCompositeFieldContainer[FuzzyTestCase]
     unit_test_suite: _containers.RepeatedCompositeFieldContainer[UnitTestCase]
-    def __init__(self, fuzzy_suite: _Optional[_Iterable[_Union[FuzzyTestCase, _Mapping]]] = ..., unit_test_suite: _Optional[_Iterable[_Union[UnitTestCase, _Mapping]]] = ...) -> None: ...
+    fuzzy_comparator: OutputComparator
+    def __init__(self, fuzzy_suite: _Optional[_Iterable[_Union[FuzzyTestCase, _Mapping]]] = ..., unit_test_suite: _Optional[_Iterable[_Union[UnitTestCase, _Mapping]]] = ..., fuzzy_comparator: _Optional[_Union[OutputComparator, _Mapping]] = ...) -> None: ...
+
+class OutputComparator(_message.Message):
+    __slots__ = ("name", "absolute_tolerance", "relative_tolerance")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    ABSOLUTE_TOLERANCE_FIELD_NUMBER: _ClassVar[int]
+    RELATIVE_TOLERANCE_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    absolute_tolerance: float
+    relative_tolerance: float
+    def __init__(self, name: _Optional[str] = ..., absolute_tolerance: _Optional[float] = ..., relative_tolerance: _Optional[float] = ...) -> None: ...
 
 class FuzzyTestCase(_message.Message):
-    __slots__ = ("stdin_input", "expected_output")
+    __slots__ = ("stdin_input", "expected_output", "comparator")
     STDIN_INPUT_FIELD_NUMBER: _ClassVar[int]
     EXPECTED_OUTPUT_FIELD_NUMBER: _ClassVar[int]
+    COMPARATOR_FIELD_NUMBER: _ClassVar[int]
     stdin_input: str
     expected_output: str
-    def __init__(self, stdin_input: _Optional[str] = ..., expected_output: _Optional[str] = ...) -> None: ...
+    comparator: OutputComparator
+    def __init__(self, stdin_input: _Optional[str] = ..., expected_output: _Optional[str] = ..., comparator: _Optional[_Union[OutputComparator, _Mapping]] = ...) -> None: ...
 
 class ResponseFuzzyTestCase(_message.Message):
-    __slots__ = ("stdin_input", "expected_output", "actual_output", "passed", "executed_code")
+    __slots__ = ("stdin_input", "expected_output", "actual_output", "passed", "executed_code", "comparator", "exit_code_zero", "phases", "stdout", "stderr", "exit_code", "wall_time_ms", "peak_memory_bytes")
     STDIN_INPUT_FIELD_NUMBER: _ClassVar[int]
     EXPECTED_OUTPUT_FIELD_NUMBER: _ClassVar[int]
     ACTUAL_OUTPUT_FIELD_NUMBER: _ClassVar[int]
     PASSED_FIELD_NUMBER: _ClassVar[int]
     EXECUTED_CODE_FIELD_NUMBER: _ClassVar[int]
+    COMPARATOR_FIELD_NUMBER: _ClassVar[int]
+    EXIT_CODE_ZERO_FIELD_NUMBER: _ClassVar[int]
+    PHASES_FIELD_NUMBER: _ClassVar[int]
+    STDOUT_FIELD_NUMBER: _ClassVar[int]
+    STDERR_FIELD_NUMBER: _ClassVar[int]
+    EXIT_CODE_FIELD_NUMBER: _ClassVar[int]
+    WALL_TIME_MS_FIELD_NUMBER: _ClassVar[int]
+    PEAK_MEMORY_BYTES_FIELD_NUMBER: _ClassVar[int]
     stdin_input: str
     expected_output: str
     actual_output: str
     passed: bool
     executed_code: str
-    def __init__(self, stdin_input: _Optional[str] = ..., expected_output: _Optional[str] = ..., actual_output: _Optional[str] = ..., passed: bool = ..., executed_code: _Optional[str] = ...) -> None: ...
+    comparator: str
+    exit_code_zero: bool
+    phases: ResponseExecutionPhases
+    stdout: str
+    stderr: str
+    exit_code: int
+    wall_time_ms: int
+    peak_memory_bytes: int
+    def __init__(self, stdin_input: _Optional[str] = ..., expected_output: _Optional[str] = ..., actual_output: _Optional[str] = ..., passed: bool = ..., executed_code: _Optional[str] = ..., comparator: _Optional[str] = ..., exit_code_zero: bool = ..., phases: _Optional[_Union[ResponseExecutionPhases, _Mapping]] = ..., stdout: _Optional[str] = ..., stderr: _Optional[str] = ..., exit_code: _Optional[int] = ..., wall_time_ms: _Optional[int] = ..., peak_memory_bytes: _Optional[int] = ...) -> None: ...
 
 class ResponseUnitTestCase(_message.Message):
-    __slots__ = ("source_code", "actual_output", "passed", "executed_code")
+    __slots__ = ("source_code", "actual_output", "passed", "executed_code", "exit_code_zero", "phases", "stdout", "stderr", "exit_code", "wall_time_ms", "peak_memory_bytes")
     SOURCE_CODE_FIELD_NUMBER: _ClassVar[int]
     ACTUAL_OUTPUT_FIELD_NUMBER: _ClassVar[int]
     PASSED_FIELD_NUMBER: _ClassVar[int]
     EXECUTED_CODE_FIELD_NUMBER: _ClassVar[int]
+    EXIT_CODE_ZERO_FIELD_NUMBER: _ClassVar[int]
+    PHASES_FIELD_NUMBER: _ClassVar[int]
+    STDOUT_FIELD_NUMBER: _ClassVar[int]
+    STDERR_FIELD_NUMBER: _ClassVar[int]
+    EXIT_CODE_FIELD_NUMBER: _ClassVar[int]
+    WALL_TIME_MS_FIELD_NUMBER: _ClassVar[int]
+    PEAK_MEMORY_BYTES_FIELD_NUMBER: _ClassVar[int]
     source_code: str
     actual_output: str
     passed: bool
     executed_code: str
-    def __init__(self, source_code: _Optional[str] = ..., actual_output: _Optional[str] = ..., passed: bool = ..., executed_code: _Optional[str] = ...) -> None: ...
+    exit_code_zero: bool
+    phases: ResponseExecutionPhases
+    stdout: str
+    stderr: str
+    exit_code: int
+    wall_time_ms: int
+    peak_memory_bytes: int
+    def __init__(self, source_code: _Optional[str] = ..., actual_output: _Optional[str] = ..., passed: bool = ..., executed_code: _Optional[str] = ..., exit_code_zero: bool = ..., phases: _Optional[_Union[ResponseExecutionPhases, _Mapping]] = ..., stdout: _Optional[str] = ..., stderr: _Optional[str] = ..., exit_code: _Optional[int] = ..., wall_time_ms: _Optional[int] = ..., peak_memory_bytes: _Optional[int] = ...) -> None: ...
+
+class ResponseExecutionPhases(_message.Message):
+    __slots__ = ("compile_failed", "compile_output", "started", "runtime_exit_code", "signal", "runtime_stderr", "timed_out")
+    COMPILE_FAILED_FIELD_NUMBER: _ClassVar[int]
+    COMPILE_OUTPUT_FIELD_NUMBER: _ClassVar[int]
+    STARTED_FIELD_NUMBER: _ClassVar[int]
+    RUNTIME_EXIT_CODE_FIELD_NUMBER: _ClassVar[int]
+    SIGNAL_FIELD_NUMBER: _ClassVar[int]
+    RUNTIME_STDERR_FIELD_NUMBER: _ClassVar[int]
+    TIMED_OUT_FIELD_NUMBER: _ClassVar[int]
+    compile_failed: bool
+    compile_output: str
+    started: bool
+    runtime_exit_code: int
+    signal: str
+    runtime_stderr: str
+    timed_out: bool
+    def __init__(self, compile_failed: bool = ..., compile_output: _Optional[str] = ..., started: bool = ..., runtime_exit_code: _Optional[int] = ..., signal: _Optional[str] = ..., runtime_stderr: _Optional[str] = ..., timed_out: bool = ...) -> None: ...
 
 class UnitTestCase(_message.Message):
     __slots__ = ("language", "test_case", "imports")
@@ -84,7 +148,7 @@ class TargetSignature(_message.Message):
     def __init__(self, language: _Optional[str] = ..., signature: _Optional[str] = ...) -> None: ...
 
 class TranslationRequest(_message.Message):
-    __slots__ = ("id", "seed_language", "target_language", "seed_code", "test_suite", "used_languages", "prompt_template_name", "target_signatures", "regex_template_name", "model_name", "extra_prompt_data")
+    __slots__ = ("id", "seed_language", "target_language", "seed_code", "test_suite", "used_languages", "prompt_template_name", "target_signatures", "regex_template_name", "model_name", "extra_prompt_data", "overrides", "budget")
     ID_FIELD_NUMBER: _ClassVar[int]
     SEED_LANGUAGE_FIELD_NUMBER: _ClassVar[int]
     TARGET_LANGUAGE_FIELD_NUMBER: _ClassVar[int]
@@ -96,6 +160,8 @@ class TranslationRequest(_message.Message):
     REGEX_TEMPLATE_NAME_FIELD_NUMBER: _ClassVar[int]
     MODEL_NAME_FIELD_NUMBER: _ClassVar[int]
     EXTRA_PROMPT_DATA_FIELD_NUMBER: _ClassVar[int]
+    OVERRIDES_FIELD_NUMBER: _ClassVar[int]
+    BUDGET_FIELD_NUMBER: _ClassVar[int]
     id: str
     seed_language: str
     target_language: str
@@ -107,10 +173,90 @@ class TranslationRequest(_message.Message):
     regex_template_name: str
     model_name: str
     extra_prompt_data: str
-    def __init__(self, id: _Optional[str] = ..., seed_language: _Optional[str] = ..., target_language: _Optional[str] = ..., seed_code: _Optional[str] = ..., test_suite: _Optional[_Union[TestSuite, _Mapping]] = ..., used_languages: _Optional[_Iterable[str]] = ..., prompt_template_name: _Optional[str] = ..., target_signatures: _Optional[_Iterable[_Union[TargetSignature, _Mapping]]] = ..., regex_template_name: _Optional[str] = ..., model_name: _Optional[str] = ..., extra_prompt_data: _Optional[str] = ...) -> None: ...
+    overrides: ConfigOverrides
+    budget: Budget
+    def __init__(self, id: _Optional[str] = ..., seed_language: _Optional[str] = ..., target_language: _Optional[str] = ..., seed_code: _Optional[str] = ..., test_suite: _Optional[_Union[TestSuite, _Mapping]] = ..., used_languages: _Optional[_Iterable[str]] = ..., prompt_template_name: _Optional[str] = ..., target_signatures: _Optional[_Iterable[_Union[TargetSignature, _Mapping]]] = ..., regex_template_name: _Optional[str] = ..., model_name: _Optional[str] = ..., extra_prompt_data: _Optional[str] = ..., overrides: _Optional[_Union[ConfigOverrides, _Mapping]] = ..., budget: _Optional[_Union[Budget, _Mapping]] = ...) -> None: ...
+
+class Budget(_message.Message):
+    __slots__ = ("max_inferences", "max_generated_tokens", "max_executions", "max_wall_time_ms")
+    MAX_INFERENCES_FIELD_NUMBER: _ClassVar[int]
+    MAX_GENERATED_TOKENS_FIELD_NUMBER: _ClassVar[int]
+    MAX_EXECUTIONS_FIELD_NUMBER: _ClassVar[int]
+    MAX_WALL_TIME_MS_FIELD_NUMBER: _ClassVar[int]
+    max_inferences: int
+    max_generated_tokens: int
+    max_executions: int
+    max_wall_time_ms: int
+    def __init__(self, max_inferences: _Optional[int] = ..., max_generated_tokens: _Optional[int] = ..., max_executions: _Optional[int] = ..., max_wall_time_ms: _Optional[int] = ...) -> None: ...
+
+class BoolOverride(_message.Message):
+    __slots__ = ("value",)
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    value: bool
+    def __init__(self, value: bool = ...) -> None: ...
+
+class Int32Override(_message.Message):
+    __slots__ = ("value",)
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    value: int
+    def __init__(self, value: _Optional[int] = ...) -> None: ...
+
+class FloatOverride(_message.Message):
+    __slots__ = ("value",)
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    value: float
+    def __init__(self, value: _Optional[float] = ...) -> None: ...
+
+class ConfigOverrides(_message.Message):
+    __slots__ = ("expansion_depth", "early_stop", "verify_intermediate_translations", "compute_efficient_mode", "max_generated_tokens", "temperature", "top_p", "top_k", "seed", "pan_et_al_repair_rounds", "candidates_per_edge", "search_strategy", "search_scorer", "search_width", "path_ordering", "max_repair_attempts", "code_extraction", "check_extracted_syntax", "syntax_pre_check")
+    EXPANSION_DEPTH_FIELD_NUMBER: _ClassVar[int]
+    EARLY_STOP_FIELD_NUMBER: _ClassVar[int]
+    VERIFY_INTERMEDIATE_TRANSLATIONS_FIELD_NUMBER: _ClassVar[int]
+    COMPUTE_EFFICIENT_MODE_FIELD_NUMBER: _ClassVar[int]
+    MAX_GENERATED_TOKENS_FIELD_NUMBER: _ClassVar[int]
+    TEMPERATURE_FIELD_NUMBER: _ClassVar[int]
+    TOP_P_FIELD_NUMBER: _ClassVar[int]
+    TOP_K_FIELD_NUMBER: _ClassVar[int]
+    SEED_FIELD_NUMBER: _ClassVar[int]
+    PAN_ET_AL_REPAIR_ROUNDS_FIELD_NUMBER: _ClassVar[int]
+    CANDIDATES_PER_EDGE_FIELD_NUMBER: _ClassVar[int]
+    SEARCH_STRATEGY_FIELD_NUMBER: _ClassVar[int]
+    SEARCH_SCORER_FIELD_NUMBER: _ClassVar[int]
+    SEARCH_WIDTH_FIELD_NUMBER: _ClassVar[int]
+    PATH_ORDERING_FIELD_NUMBER: _ClassVar[int]
+    MAX_REPAIR_ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    CODE_EXTRACTION_FIELD_NUMBER: _ClassVar[int]
+    CHECK_EXTRACTED_SYNTAX_FIELD_NUMBER: _ClassVar[int]
+    SYNTAX_PRE_CHECK_FIELD_NUMBER: _ClassVar[int]
+    expansion_depth: Int32Override
+    early_stop: BoolOverride
+    verify_intermediate_translations: BoolOverride
+    compute_efficient_mode: BoolOverride
+    max_generated_tokens: Int32Override
+    temperature: FloatOverride
+    top_p: FloatOverride
+    top_k: Int32Override
+    seed: Int32Override
+    pan_et_al_repair_rounds: Int32Override
+    candidates_per_edge: Int32Override
+    search_strategy: StringOverride
+    search_scorer: StringOverride
+    search_width: Int32Override
+    path_ordering: StringOverride
+    max_repair_attempts: Int32Override
+    code_extraction: StringOverride
+    check_extracted_syntax: BoolOverride
+    syntax_pre_check: BoolOverride
+    def __init__(self, expansion_depth: _Optional[_Union[Int32Override, _Mapping]] = ..., early_stop: _Optional[_Union[BoolOverride, _Mapping]] = ..., verify_intermediate_translations: _Optional[_Union[BoolOverride, _Mapping]] = ..., compute_efficient_mode: _Optional[_Union[BoolOverride, _Mapping]] = ..., max_generated_tokens: _Optional[_Union[Int32Override, _Mapping]] = ..., temperature: _Optional[_Union[FloatOverride, _Mapping]] = ..., top_p: _Optional[_Union[FloatOverride, _Mapping]] = ..., top_k: _Optional[_Union[Int32Override, _Mapping]] = ..., seed: _Optional[_Union[Int32Override, _Mapping]] = ..., pan_et_al_repair_rounds: _Optional[_Union[Int32Override, _Mapping]] = ..., candidates_per_edge: _Optional[_Union[Int32Override, _Mapping]] = ..., search_strategy: _Optional[_Union[StringOverride, _Mapping]] = ..., search_scorer: _Optional[_Union[StringOverride, _Mapping]] = ..., search_width: _Optional[_Union[Int32Override, _Mapping]] = ..., path_ordering: _Optional[_Union[StringOverride, _Mapping]] = ..., max_repair_attempts: _Optional[_Union[Int32Override, _Mapping]] = ..., code_extraction: _Optional[_Union[StringOverride, _Mapping]] = ..., check_extracted_syntax: _Optional[_Union[BoolOverride, _Mapping]] = ..., syntax_pre_check: _Optional[_Union[BoolOverride, _Mapping]] = ...) -> None: ...
+
+class StringOverride(_message.Message):
+    __slots__ = ("value",)
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    value: str
+    def __init__(self, value: _Optional[str] = ...) -> None: ...
 
 class ResponseTranslationEdge(_message.Message):
-    __slots__ = ("prompt_template", "prompt", "translation_id", "input_language", "target_language", "level", "success", "inference_output", "execution_output", "source_code", "extracted_source_code", "parent_edge_id", "status", "fuzzy_tests", "unit_tests", "edge_id", "wallTimeInference", "wallTimeTestExecution", "usedMemoization", "usedInferenceCache")
+    __slots__ = ("prompt_template", "prompt", "translation_id", "input_language", "target_language", "level", "success", "inference_output", "execution_output", "source_code", "extracted_source_code", "parent_edge_id", "status", "fuzzy_tests", "unit_tests", "edge_id", "wallTimeInference", "wallTimeTestExecution", "usedMemoization", "usedInferenceCache", "failed_test_categories", "candidate_index", "usage", "log_prob", "repair_attempt", "extraction_strategy")
     PROMPT_TEMPLATE_FIELD_NUMBER: _ClassVar[int]
     PROMPT_FIELD_NUMBER: _ClassVar[int]
     TRANSLATION_ID_FIELD_NUMBER: _ClassVar[int]
@@ -131,6 +277,12 @@ class ResponseTranslationEdge(_message.Message):
     WALLTIMETESTEXECUTION_FIELD_NUMBER: _ClassVar[int]
     USEDMEMOIZATION_FIELD_NUMBER: _ClassVar[int]
     USEDINFERENCECACHE_FIELD_NUMBER: _ClassVar[int]
+    FAILED_TEST_CATEGORIES_FIELD_NUMBER: _ClassVar[int]
+    CANDIDATE_INDEX_FIELD_NUMBER: _ClassVar[int]
+    USAGE_FIELD_NUMBER: _ClassVar[int]
+    LOG_PROB_FIELD_NUMBER: _ClassVar[int]
+    REPAIR_ATTEMPT_FIELD_NUMBER: _ClassVar[int]
+    EXTRACTION_STRATEGY_FIELD_NUMBER: _ClassVar[int]
     prompt_template: str
     prompt: str
     translation_id: str
@@ -151,7 +303,37 @@ class ResponseTranslationEdge(_message.Message):
     wallTimeTestExecution: int
     usedMemoization: bool
     usedInferenceCache: bool
-    def __init__(self, prompt_template: _Optional[str] = ..., prompt: _Optional[str] = ..., translation_id: _Optional[str] = ..., input_language: _Optional[str] = ..., target_language: _Optional[str] = ..., level: _Optional[int] = ..., success: bool = ..., inference_output: _Optional[str] = ..., execution_output: _Optional[str] = ..., source_code: _Optional[str] = ..., extracted_source_code: _Optional[str] = ..., parent_edge_id: _Optional[int] = ..., status: _Optional[str] = ..., fuzzy_tests: _Optional[_Iterable[_Union[ResponseFuzzyTestCase, _Mapping]]] = ..., unit_tests: _Optional[_Iterable[_Union[ResponseUnitTestCase, _Mapping]]] = ..., edge_id: _Optional[int] = ..., wallTimeInference: _Optional[int] = ..., wallTimeTestExecution: _Optional[int] = ..., usedMemoization: bool = ..., usedInferenceCache: bool = ...) -> None: ...
+    failed_test_categories: _containers.RepeatedScalarFieldContainer[str]
+    candidate_index: int
+    usage: ResponseTokenUsage
+    log_prob: float
+    repair_attempt: int
+    extraction_strategy: str
+    def __init__(self, prompt_template: _Optional[str] = ..., prompt: _Optional[str] = ..., translation_id: _Optional[str] = ..., input_language: _Optional[str] = ..., target_language: _Optional[str] = ..., level: _Optional[int] = ..., success: bool = ..., inference_output: _Optional[str] = ..., execution_output: _Optional[str] = ..., source_code: _Optional[str] = ..., extracted_source_code: _Optional[str] = ..., parent_edge_id: _Optional[int] = ..., status: _Optional[str] = ..., fuzzy_tests: _Optional[_Iterable[_Union[ResponseFuzzyTestCase, _Mapping]]] = ..., unit_tests: _Optional[_Iterable[_Union[ResponseUnitTestCase, _Mapping]]] = ..., edge_id: _Optional[int] = ..., wallTimeInference: _Optional[int] = ..., wallTimeTestExecution: _Optional[int] = ..., usedMemoization: bool = ..., usedInferenceCache: bool = ..., failed_test_categories: _Optional[_Iterable[str]] = ..., candidate_index: _Optional[int] = ..., usage: _Optional[_Union[ResponseTokenUsage, _Mapping]] = ..., log_prob: _Optional[float] = ..., repair_attempt: _Optional[int] = ..., extraction_strategy: _Optional[str] = ...) -> None: ...
+
+class ResponseTokenUsage(_message.Message):
+    __slots__ = ("prompt_tokens", "completion_tokens", "estimated_cost")
+    PROMPT_TOKENS_FIELD_NUMBER: _ClassVar[int]
+    COMPLETION_TOKENS_FIELD_NUMBER: _ClassVar[int]
+    ESTIMATED_COST_FIELD_NUMBER: _ClassVar[int]
+    prompt_tokens: int
+    completion_tokens: int
+    estimated_cost: float
+    def __init__(self, prompt_tokens: _Optional[int] = ..., completion_tokens: _Optional[int] = ..., estimated_cost: _Optional[float] = ...) -> None: ...
+
+class ResponseBudgetUsage(_message.Message):
+    __slots__ = ("inferences", "generated_tokens", "executions", "wall_time_ms", "exhausted_budget")
+    INFERENCES_FIELD_NUMBER: _ClassVar[int]
+    GENERATED_TOKENS_FIELD_NUMBER: _ClassVar[int]
+    EXECUTIONS_FIELD_NUMBER: _ClassVar[int]
+    WALL_TIME_MS_FIELD_NUMBER: _ClassVar[int]
+    EXHAUSTED_BUDGET_FIELD_NUMBER: _ClassVar[int]
+    inferences: int
+    generated_tokens: int
+    executions: int
+    wall_time_ms: int
+    exhausted_budget: str
+    def __init__(self, inferences: _Optional[int] = ..., generated_tokens: _Optional[int] = ..., executions: _Optional[int] = ..., wall_time_ms: _Optional[int] = ..., exhausted_budget: _Optional[str] = ...) -> None: ...
 
 class ResponseTranslationPath(_message.Message):
     __slots__ = ("translation_edges", "edge_index_memoized")
@@ -162,34 +344,124 @@ class ResponseTranslationPath(_message.Message):
     def __init__(self, translation_edges: _Optional[_Iterable[_Union[ResponseTranslationEdge, _Mapping]]] = ..., edge_index_memoized: _Optional[_Iterable[bool]] = ...) -> None: ...
 
 class TranslationResponse(_message.Message):
-    __slots__ = ("translation_request", "paths")
+    __slots__ = ("translation_request", "paths", "error", "usage", "budget_usage")
     TRANSLATION_REQUEST_FIELD_NUMBER: _ClassVar[int]
     PATHS_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    USAGE_FIELD_NUMBER: _ClassVar[int]
+    BUDGET_USAGE_FIELD_NUMBER: _ClassVar[int]
     translation_request: TranslationRequest
     paths: _containers.RepeatedCompositeFieldContainer[ResponseTranslationPath]
-    def __init__(self, translation_request: _Optional[_Union[TranslationRequest, _Mapping]] = ..., paths: _Optional[_Iterable[_Union[ResponseTranslationPath, _Mapping]]] = ...) -> None: ...
+    error: str
+    usage: ResponseTokenUsage
+    budget_usage: ResponseBudgetUsage
+    def __init__(self, translation_request: _Optional[_Union[TranslationRequest, _Mapping]] = ..., paths: _Optional[_Iterable[_Union[ResponseTranslationPath, _Mapping]]] = ..., error: _Optional[str] = ..., usage: _Optional[_Union[ResponseTokenUsage, _Mapping]] = ..., budget_usage: _Optional[_Union[ResponseBudgetUsage, _Mapping]] = ...) -> None: ...
 
 class BatchTranslationRequest(_message.Message):
-    __slots__ = ("translation_requests", "id", "file_base_name", "file_save_path")
+    __slots__ = ("translation_requests", "id", "file_base_name", "file_save_path", "overrides", "budget")
     TRANSLATION_REQUESTS_FIELD_NUMBER: _ClassVar[int]
     ID_FIELD_NUMBER: _ClassVar[int]
     FILE_BASE_NAME_FIELD_NUMBER: _ClassVar[int]
     FILE_SAVE_PATH_FIELD_NUMBER: _ClassVar[int]
+    OVERRIDES_FIELD_NUMBER: _ClassVar[int]
+    BUDGET_FIELD_NUMBER: _ClassVar[int]
     translation_requests: _containers.RepeatedCompositeFieldContainer[TranslationRequest]
     id: str
     file_base_name: str
     file_save_path: str
-    def __init__(self, translation_requests: _Optional[_Iterable[_Union[TranslationRequest, _Mapping]]] = ..., id: _Optional[str] = ..., file_base_name: _Optional[str] = ..., file_save_path: _Optional[str] = ...) -> None: ...
+    overrides: ConfigOverrides
+    budget: Budget
+    def __init__(self, translation_requests: _Optional[_Iterable[_Union[TranslationRequest, _Mapping]]] = ..., id: _Optional[str] = ..., file_base_name: _Optional[str] = ..., file_save_path: _Optional[str] = ..., overrides: _Optional[_Union[ConfigOverrides, _Mapping]] = ..., budget: _Optional[_Union[Budget, _Mapping]] = ...) -> None: ...
 
 class BatchTranslationResponse(_message.Message):
-    __slots__ = ("translation_responses", "request_id", "returnedToDisk")
+    __slots__ = ("translation_responses", "request_id", "returnedToDisk", "usage", "budget_usage")
     TRANSLATION_RESPONSES_FIELD_NUMBER: _ClassVar[int]
     REQUEST_ID_FIELD_NUMBER: _ClassVar[int]
     RETURNEDTODISK_FIELD_NUMBER: _ClassVar[int]
+    USAGE_FIELD_NUMBER: _ClassVar[int]
+    BUDGET_USAGE_FIELD_NUMBER: _ClassVar[int]
     translation_responses: _containers.RepeatedCompositeFieldContainer[TranslationResponse]
     request_id: str
     returnedToDisk: bool
-    def __init__(self, translation_responses: _Optional[_Iterable[_Union[TranslationResponse, _Mapping]]] = ..., request_id: _Optional[str] = ..., returnedToDisk: bool = ...) -> None: ...
+    usage: ResponseTokenUsage
+    budget_usage: ResponseBudgetUsage
+    def __init__(self, translation_responses: _Optional[_Iterable[_Union[TranslationResponse, _Mapping]]] = ..., request_id: _Optional[str] = ..., returnedToDisk: bool = ..., usage: _Optional[_Union[ResponseTokenUsage, _Mapping]] = ..., budget_usage: _Optional[_Union[ResponseBudgetUsage, _Mapping]] = ...) -> None: ...
+
+class TranslationEvent(_message.Message):
+    __slots__ = ("request_id", "edge", "translation_response")
+    REQUEST_ID_FIELD_NUMBER: _ClassVar[int]
+    EDGE_FIELD_NUMBER: _ClassVar[int]
+    TRANSLATION_RESPONSE_FIELD_NUMBER: _ClassVar[int]
+    request_id: str
+    edge: ResponseTranslationEdge
+    translation_response: TranslationResponse
+    def __init__(self, request_id: _Optional[str] = ..., edge: _Optional[_Union[ResponseTranslationEdge, _Mapping]] = ..., translation_response: _Optional[_Union[TranslationResponse, _Mapping]] = ...) -> None: ...
+
+class LanguageStatisticsRequest(_message.Message):
+    __slots__ = ("model_name", "seed_language", "target_language")
+    MODEL_NAME_FIELD_NUMBER: _ClassVar[int]
+    SEED_LANGUAGE_FIELD_NUMBER: _ClassVar[int]
+    TARGET_LANGUAGE_FIELD_NUMBER: _ClassVar[int]
+    model_name: str
+    seed_language: str
+    target_language: str
+    def __init__(self, model_name: _Optional[str] = ..., seed_language: _Optional[str] = ..., target_language: _Optional[str] = ...) -> None: ...
+
+class LanguagePathStatistics(_message.Message):
+    __slots__ = ("model_name", "target_language", "languages", "attempts", "successes", "inference_failures", "extraction_failures", "compilation_runtime_failures", "test_failures", "timeout_failures", "success_rate")
+    MODEL_NAME_FIELD_NUMBER: _ClassVar[int]
+    TARGET_LANGUAGE_FIELD_NUMBER: _ClassVar[int]
+    LANGUAGES_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    SUCCESSES_FIELD_NUMBER: _ClassVar[int]
+    INFERENCE_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    EXTRACTION_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    COMPILATION_RUNTIME_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    TEST_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    SUCCESS_RATE_FIELD_NUMBER: _ClassVar[int]
+    model_name: str
+    target_language: str
+    languages: _containers.RepeatedScalarFieldContainer[str]
+    attempts: int
+    successes: int
+    inference_failures: int
+    extraction_failures: int
+    compilation_runtime_failures: int
+    test_failures: int
+    timeout_failures: int
+    success_rate: float
+    def __init__(self, model_name: _Optional[str] = ..., target_language: _Optional[str] = ..., languages: _Optional[_Iterable[str]] = ..., attempts: _Optional[int] = ..., successes: _Optional[int] = ..., inference_failures: _Optional[int] = ..., extraction_failures: _Optional[int] = ..., compilation_runtime_failures: _Optional[int] = ..., test_failures: _Optional[int] = ..., timeout_failures: _Optional[int] = ..., success_rate: _Optional[float] = ...) -> None: ...
+
+class LanguageStatisticsResponse(_message.Message):
+    __slots__ = ("statistics",)
+    STATISTICS_FIELD_NUMBER: _ClassVar[int]
+    statistics: _containers.RepeatedCompositeFieldContainer[LanguagePathStatistics]
+    def __init__(self, statistics: _Optional[_Iterable[_Union[LanguagePathStatistics, _Mapping]]] = ...) -> None: ...
+
+class JobRequest(_message.Message):
+    __slots__ = ("job_id",)
+    JOB_ID_FIELD_NUMBER: _ClassVar[int]
+    job_id: str
+    def __init__(self, job_id: _Optional[str] = ...) -> None: ...
+
+class JobStatus(_message.Message):
+    __slots__ = ("job_id", "status", "total_requests", "completed_requests", "error", "submitted_at", "finished_at")
+    JOB_ID_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    TOTAL_REQUESTS_FIELD_NUMBER: _ClassVar[int]
+    COMPLETED_REQUESTS_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    SUBMITTED_AT_FIELD_NUMBER: _ClassVar[int]
+    FINISHED_AT_FIELD_NUMBER: _ClassVar[int]
+    job_id: str
+    status: ResponseStatus
+    total_requests: int
+    completed_requests: int
+    error: str
+    submitted_at: int
+    finished_at: int
+    def __init__(self, job_id: _Optional[str] = ..., status: _Optional[_Union[ResponseStatus, str]] = ..., total_requests: _Optional[int] = ..., completed_requests: _Optional[int] = ..., error: _Optional[str] = ..., submitted_at: _Optional[int] = ..., finished_at: _Optional[int] = ...) -> None: ...
 
 class StartEndpointRequest(_message.Message):
     __slots__ = ("model_name", "gpu_id", "port", "seed", "api_token", "lora_path")
@@ -234,16 +506,20 @@ class VerificationRequest(_message.Message):
     def __init__(self, id: _Optional[str] = ..., test_suite: _Optional[_Union[TestSuite, _Mapping]] = ..., inferenceOutput: _Optional[str] = ..., targetLanguage: _Optional[str] = ..., sourceLanguage: _Optional[str] = ...) -> None: ...
 
 class VerificationResponse(_message.Message):
-    __slots__ = ("verification_request", "fuzzy_tests", "unit_tests", "status")
+    __slots__ = ("verification_request", "fuzzy_tests", "unit_tests", "status", "failed_test_categories", "error")
     VERIFICATION_REQUEST_FIELD_NUMBER: _ClassVar[int]
     FUZZY_TESTS_FIELD_NUMBER: _ClassVar[int]
     UNIT_TESTS_FIELD_NUMBER: _ClassVar[int]
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    FAILED_TEST_CATEGORIES_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
     verification_request: VerificationRequest
     fuzzy_tests: _containers.RepeatedCompositeFieldContainer[ResponseFuzzyTestCase]
     unit_tests: _containers.RepeatedCompositeFieldContainer[ResponseUnitTestCase]
     status: str
-    def __init__(self, verification_request: _Optional[_Union[VerificationRequest, _Mapping]] = ..., fuzzy_tests: _Optional[_Iterable[_Union[ResponseFuzzyTestCase, _Mapping]]] = ..., unit_tests: _Optional[_Iterable[_Union[ResponseUnitTestCase, _Mapping]]] = ..., status: _Optional[str] = ...) -> None: ...
+    failed_test_categories: _containers.RepeatedScalarFieldContainer[str]
+    error: str
+    def __init__(self, verification_request: _Optional[_Union[VerificationRequest, _Mapping]] = ..., fuzzy_tests: _Optional[_Iterable[_Union[ResponseFuzzyTestCase, _Mapping]]] = ..., unit_tests: _Optional[_Iterable[_Union[ResponseUnitTestCase, _Mapping]]] = ..., status: _Optional[str] = ..., failed_test_categories: _Optional[_Iterable[str]] = ..., error: _Optional[str] = ...) -> None: ...
 
 class BatchVerificationRequest(_message.Message):
     __slots__ = ("verification_requests", "id")
@@ -19,6 +19,11 @@ class TranslationServiceStub(object):
                 request_serializer=protos__pb2.BatchTranslationRequest.SerializeToString,
                 response_deserializer=protos__pb2.BatchTranslationResponse.FromString,
                 )
+        self.BatchTranslateStream = channel.unary_stream(
+                '/TranslationService/BatchTranslateStream',
+                request_serializer=protos__pb2.BatchTranslationRequest.SerializeToString,
+                response_deserializer=protos__pb2.TranslationEvent.FromString,
+                )
         self.BatchTranslateCAK = channel.unary_unary(
                 '/TranslationService/BatchTranslateCAK',
                 request_serializer=protos__pb2.BatchTranslationRequest.SerializeToString,
@@ -34,6 +39,11 @@ class TranslationServiceStub(object):
                 request_serializer=protos__pb2.BatchVerificationRequest.SerializeToString,
                 response_deserializer=protos__pb2.BatchVerificationResponse.FromString,
                 )
+        self.GetLanguageStatistics = channel.unary_unary(
+                '/TranslationService/GetLanguageStatistics',
+                request_serializer=protos__pb2.LanguageStatisticsRequest.SerializeToString,
+                response_deserializer=protos__pb2.LanguageStatisticsResponse.FromString,
+                )
 
 
 class TranslationServiceServicer(object):
@@ -45,6 +55,12 @@ class TranslationServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def BatchTranslateStream(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def BatchTranslateCAK(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -63,6 +79,12 @@ class TranslationServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetLanguageStatistics(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_TranslationServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -71,6 +93,11 @@ def add_TranslationServiceServicer_to_server(servicer, server):
                     request_deserializer=protos__pb2.BatchTranslationRequest.FromString,
                     response_serializer=protos__pb2.BatchTranslationResponse.SerializeToString,
             ),
+            'BatchTranslateStream': grpc.unary_stream_rpc_method_handler(
+                    servicer.BatchTranslateStream,
+                    request_deserializer=protos__pb2.BatchTranslationRequest.FromString,
+                    response_serializer=protos__pb2.TranslationEvent.SerializeToString,
+            ),
             'BatchTranslateCAK': grpc.unary_unary_rpc_method_handler(
                     servicer.BatchTranslateCAK,
                     request_deserializer=protos__pb2.BatchTranslationRequest.FromString,
@@ -86,6 +113,11 @@ def add_TranslationServiceServicer_to_server(servicer, server):
                     request_deserializer=protos__pb2.BatchVerificationRequest.FromString,
                     response_serializer=protos__pb2.BatchVerificationResponse.SerializeToString,
             ),
+            'GetLanguageStatistics': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetLanguageStatistics,
+                    request_deserializer=protos__pb2.LanguageStatisticsRequest.FromString,
+                    response_serializer=protos__pb2.LanguageStatisticsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'TranslationService', rpc_method_handlers)
@@ -113,6 +145,23 @@ class TranslationService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def BatchTranslateStream(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/TranslationService/BatchTranslateStream',
+            protos__pb2.BatchTranslationRequest.SerializeToString,
+            protos__pb2.TranslationEvent.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def BatchTranslateCAK(request,
             target,
@@ -164,6 +213,183 @@ class TranslationService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetLanguageStatistics(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/TranslationService/GetLanguageStatistics',
+            protos__pb2.LanguageStatisticsRequest.SerializeToString,
+            protos__pb2.LanguageStatisticsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class JobServiceStub(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.SubmitBatch = channel.unary_unary(
+                '/JobService/SubmitBatch',
+                request_serializer=protos__pb2.BatchTranslationRequest.SerializeToString,
+                response_deserializer=protos__pb2.JobStatus.FromString,
+                )
+        self.GetJobStatus = channel.unary_unary(
+                '/JobService/GetJobStatus',
+                request_serializer=protos__pb2.JobRequest.SerializeToString,
+                response_deserializer=protos__pb2.JobStatus.FromString,
+                )
+        self.GetJobResult = channel.unary_unary(
+                '/JobService/GetJobResult',
+                request_serializer=protos__pb2.JobRequest.SerializeToString,
+                response_deserializer=protos__pb2.BatchTranslationResponse.FromString,
+                )
+        self.CancelJob = channel.unary_unary(
+                '/JobService/CancelJob',
+                request_serializer=protos__pb2.JobRequest.SerializeToString,
+                response_deserializer=protos__pb2.JobStatus.FromString,
+                )
+
+
+class JobServiceServicer(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def SubmitBatch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetJobStatus(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetJobResult(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CancelJob(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_JobServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'SubmitBatch': grpc.unary_unary_rpc_method_handler(
+                    servicer.SubmitBatch,
+                    request_deserializer=protos__pb2.BatchTranslationRequest.FromString,
+                    response_serializer=protos__pb2.JobStatus.SerializeToString,
+            ),
+            'GetJobStatus': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetJobStatus,
+                    request_deserializer=protos__pb2.JobRequest.FromString,
+                    response_serializer=protos__pb2.JobStatus.SerializeToString,
+            ),
+            'GetJobResult': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetJobResult,
+                    request_deserializer=protos__pb2.JobRequest.FromString,
+                    response_serializer=protos__pb2.BatchTranslationResponse.SerializeToString,
+            ),
+            'CancelJob': grpc.unary_unary_rpc_method_handler(
+                    servicer.CancelJob,
+                    request_deserializer=protos__pb2.JobRequest.FromString,
+                    response_serializer=protos__pb2.JobStatus.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'JobService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class JobService(object):
+    """Missing associated documentation comment in .proto file."""
+
+    @staticmethod
+    def SubmitBatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/JobService/SubmitBatch',
+            protos__pb2.BatchTranslationRequest.SerializeToString,
+            protos__pb2.JobStatus.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetJobStatus(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/JobService/GetJobStatus',
+            protos__pb2.JobRequest.SerializeToString,
+            protos__pb2.JobStatus.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetJobResult(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/JobService/GetJobResult',
+            protos__pb2.JobRequest.SerializeToString,
+            protos__pb2.BatchTranslationResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CancelJob(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/JobService/CancelJob',
+            protos__pb2.JobRequest.SerializeToString,
+            protos__pb2.JobStatus.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
 
 class InfrastructureServiceStub(object):
     """Missing associated documentation comment in .proto file."""
@@ -95,6 +95,18 @@ def submit_request(batch_request, grpc_channel_address):
 
     return response
 
+def stream_request(batch_request, grpc_channel_address):
+    options = [
+    ('grpc.max_send_message_length', 1000 * 1024 * 1024 * 2), 
+    ('grpc.max_receive_message_length', 1000 * 1024 * 1024 * 2) 
+    ]
+
+    with grpc.insecure_channel(grpc_channel_address, options=options) as channel:
+        stub = ptgrpc.TranslationServiceStub(channel)
+
+        for event in stub.BatchTranslateStream(batch_request):
+            yield event
+
 def submit_request_cak(batch_request, grpc_channel_address):
     options = [
     ('grpc.max_send_message_length', 1000 * 1024 * 1024 * 2), 
@@ -107,6 +119,18 @@ def submit_request_cak(batch_request, grpc_channel_address):
 
     return response
 
+def submit_request_pan_et_al(batch_request, grpc_channel_address):
+    options = [
+    ('grpc.max_send_message_length', 1000 * 1024 * 1024 * 2), 
+    ('grpc.max_receive_message_length', 1000 * 1024 * 1024 * 2) 
+    ]
+
+    with grpc.insecure_channel(grpc_channel_address, options=options) as channel:
+        stub = ptgrpc.TranslationServiceStub(channel)
+        response = stub.BatchPanEtAlTranslate(batch_request)
+
+    return response
+
 def submit_request_execute(batch_request, grpc_channel_address):
     options = [
     ('grpc.max_send_message_length', 1000 * 1024 * 1024 * 2), 
@@ -119,6 +143,63 @@ def submit_request_execute(batch_request, grpc_channel_address):
 
     return response
 
+def submit_job(batch_request, grpc_channel_address):
+    options = [
+    ('grpc.max_send_message_length', 1000 * 1024 * 1024 * 2), 
+    ('grpc.max_receive_message_length', 1000 * 1024 * 1024 * 2) 
+    ]
+
+    with grpc.insecure_channel(grpc_channel_address, options=options) as channel:
+        stub = ptgrpc.JobServiceStub(channel)
+        response = stub.SubmitBatch(batch_request)
+
+    return response.job_id
+
+def get_job_status(job_id, grpc_channel_address):
+    with grpc.insecure_channel(grpc_channel_address) as channel:
+        stub = ptgrpc.JobServiceStub(channel)
+        response = stub.GetJobStatus(ptpb.JobRequest(job_id=job_id))
+
+    return response
+
+def get_job_result(job_id, grpc_channel_address):
+    options = [
+    ('grpc.max_send_message_length', 1000 * 1024 * 1024 * 2), 
+    ('grpc.max_receive_message_length', 1000 * 1024 * 1024 * 2) 
+    ]
+
+    with grpc.insecure_channel(grpc_channel_address, options=options) as channel:
+        stub = ptgrpc.JobServiceStub(channel)
+        response = stub.GetJobResult(ptpb.JobRequest(job_id=job_id))
+
+    return response
+
+def cancel_job(job_id, grpc_channel_address):
+    with grpc.insecure_channel(grpc_channel_address) as channel:
+        stub = ptgrpc.JobServiceStub(channel)
+        response = stub.CancelJob(ptpb.JobRequest(job_id=job_id))
+
+    return response
+
+def get_language_statistics(grpc_channel_address, model_name="", seed_language="", target_language=""):
+    with grpc.insecure_channel(grpc_channel_address) as channel:
+        stub = ptgrpc.TranslationServiceStub(channel)
+        response = stub.GetLanguageStatistics(ptpb.LanguageStatisticsRequest(model_name=model_name, seed_language=seed_language, target_language=target_language))
+
+    return response.statistics
+
+def wait_for_job(job_id, grpc_channel_address, poll_interval=30):
+    while True:
+        status = get_job_status(job_id, grpc_channel_address)
+
+        if status.status == ptpb.FAILED:
+            raise Exception(f"Job {job_id} failed: {status.error}")
+
+        if status.status in (ptpb.DONE, ptpb.CANCELLED):
+            return get_job_result(job_id, grpc_channel_address)
+
+        time.sleep(poll_interval)
+
 def submit_infra_request(infra_request, grpc_channel_address):
     options = [
     ('grpc.max_send_message_length', 1000 * 1024 * 1024),  # 1000 MB
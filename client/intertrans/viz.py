@@ -31,6 +31,11 @@ def plot_graph(dataframe):
         "SKIPPED_PARENT_FAILED": "grey",
         "SKIPPED_NO_EXTRACT": "grey",
         "FAILED_NO_EXTRACTED" : "red",
+        "FAILED_SYNTAX" : "red",
+        "FAILED_COMPILATION" : "red",
+        "FAILED_RUNTIME" : "red",
+        "CANCELLED" : "grey",
+        "BUDGET_EXHAUSTED" : "grey",
         "ROOT" : "skyblue"
     }
 
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// Names of the budgets reported in exhausted_budget
+const (
+	InferencesBudget      = "max_inferences"
+	GeneratedTokensBudget = "max_generated_tokens"
+	ExecutionsBudget      = "max_executions"
+	WallTimeBudget        = "max_wall_time_ms"
+)
+
+// Work done for a request or a batch against its budget. The budget of a request is also charged to the budget of
+// its batch, and either of them being spent stops the request. A nil tracker has no budget
+type BudgetTracker struct {
+	limits          *Budget
+	parent          *BudgetTracker
+	started         time.Time
+	lock            sync.Mutex
+	inferences      int32
+	generatedTokens int64
+	executions      int32
+	exhausted       string
+}
+
+// Limits with zero values are not enforced, but the work is still counted to report it
+func NewBudgetTracker(limits *Budget, parent *BudgetTracker) *BudgetTracker {
+	return &BudgetTracker{
+		limits:  limits,
+		parent:  parent,
+		started: time.Now(),
+	}
+}
+
+// Returns the name of the first budget spent by the tracker or its parent, or "" if there is budget left. Once
+// spent, the budget stays spent so that all the remaining edges are stopped for the same reason
+func (tracker *BudgetTracker) Exhausted() string {
+	if tracker == nil {
+		return ""
+	}
+
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if tracker.exhausted == "" {
+		tracker.exhausted = tracker.checkLimits()
+	}
+
+	//Requests stopped by the budget of their batch report it too
+	if tracker.exhausted == "" {
+		tracker.exhausted = tracker.parent.Exhausted()
+	}
+
+	return tracker.exhausted
+}
+
+func (tracker *BudgetTracker) checkLimits() string {
+	limits := tracker.limits
+
+	switch {
+	case limits.GetMaxInferences() > 0 && tracker.inferences >= limits.GetMaxInferences():
+		return InferencesBudget
+	case limits.GetMaxGeneratedTokens() > 0 && tracker.generatedTokens >= limits.GetMaxGeneratedTokens():
+		return GeneratedTokensBudget
+	case limits.GetMaxExecutions() > 0 && tracker.executions >= limits.GetMaxExecutions():
+		return ExecutionsBudget
+	case limits.GetMaxWallTimeMs() > 0 && time.Since(tracker.started).Milliseconds() >= limits.GetMaxWallTimeMs():
+		return WallTimeBudget
+	}
+
+	return ""
+}
+
+// Serializes the check and the reservation of inference calls across the requests of a batch
+var reservationLock sync.Mutex
+
+// Takes an inference call from the budget before it is sent, so that concurrent paths can't exceed it. Returns
+// false without taking anything if a budget is spent
+func (tracker *BudgetTracker) ReserveInference() bool {
+	if tracker == nil {
+		return true
+	}
+
+	reservationLock.Lock()
+	defer reservationLock.Unlock()
+
+	if tracker.Exhausted() != "" {
+		return false
+	}
+
+	for current := tracker; current != nil; current = current.parent {
+		current.lock.Lock()
+		current.inferences++
+		current.lock.Unlock()
+	}
+
+	return true
+}
+
+// Inferences loaded from the cache or cancelled don't count. The generated tokens only get known after the call,
+// so the last inference may exceed the token budget
+func (tracker *BudgetTracker) RecordInference(result InferenceResult) {
+	for current := tracker; current != nil; current = current.parent {
+		current.lock.Lock()
+
+		if result.IsCached || result.Cancelled {
+			current.inferences--
+		} else {
+			current.generatedTokens += int64(result.Usage.CompletionTokens)
+		}
+
+		current.lock.Unlock()
+	}
+}
+
+func (tracker *BudgetTracker) RecordExecution() {
+	for current := tracker; current != nil; current = current.parent {
+		current.lock.Lock()
+		current.executions++
+		current.lock.Unlock()
+	}
+}
+
+// Only reads the usage. The exhausted budget is the one latched by Exhausted, which is only called before doing more
+// work, so it is only reported if it stopped some work
+func (tracker *BudgetTracker) ToResponse() *ResponseBudgetUsage {
+	if tracker == nil {
+		return nil
+	}
+
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	return &ResponseBudgetUsage{
+		Inferences:      tracker.inferences,
+		GeneratedTokens: tracker.generatedTokens,
+		Executions:      tracker.executions,
+		WallTimeMs:      time.Since(tracker.started).Milliseconds(),
+		ExhaustedBudget: tracker.exhausted,
+	}
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/gob"
 	"encoding/json"
@@ -11,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/dgraph-io/badger/v4"
@@ -19,33 +21,213 @@ import (
 )
 
 type AppConfig struct {
-	NumExecutionWorkers            int               `yaml:"numExecutionWorkers"`
-	NumInferenceWorkers            int               `yaml:"numInferenceWorkers"`
-	InferenceApiBaseUrls           []string          `yaml:"inferenceApiBaseUrls"`
-	InferenceApiToken              string            `yaml:"inferenceApiToken"`
-	ServerAddress                  string            `yaml:"serverAddress"`
-	ServerPort                     string            `yaml:"serverPort"`
-	ExpansionDepth                 int               `yaml:"expansionIntermediaryNodes"`
-	PromptTemplates                map[string]string `yaml:"promptTemplates"`
-	RegexTemplates                 map[string]string `yaml:"regexTemplates"`
-	ExecutionContainers            map[string]string `yaml:"executionContainers"`
-	ComputeEfficientMode           bool              `yaml:"useComputeEfficientMode"`
-	ApplyRegexInferenceOnly        bool              `yaml:"applyRegexInferenceOnly"`
-	EarlyStopOnTranslationSuccess  bool              `yaml:"earlyStop"`
-	UseTranscoderTestFormat        bool              `yaml:"useTranscoderTestFormat"`
-	VerifyIntermediateTranslations bool              `yaml:"verifyIntermediateTranslations"`
-	StopOnDirectTranslation        bool              `yaml:"stopOnDirectTranslation"`
-	UseIntermediatesMemoization    bool              `yaml:"useCrossPathIntermediatesMemoization"`
-	UseInferenceCache              bool              `yaml:"useInferenceCache"`
-	UseResponseCache               bool              `yaml:"useResponseCache"`
-	UseExecutionCache              bool              `yaml:"useExecutionCache"`
-	MaxGeneratedTokens             int               `yaml:"maxGeneratedTokens"`
-	TopP                           float32           `yaml:"top-p"`
-	TopK                           int               `yaml:"top-k"`
-	Temperature                    float32           `yaml:"temperature"`
-	Seed                           int               `yaml:"inferenceSeed"`
-	DatabasePath                   string            `yaml:"cacheDatabasePath"`
-	InferenceBackend               string            `yaml:"inferenceBackend"`
+	NumExecutionWorkers            int                           `yaml:"numExecutionWorkers"`
+	NumInferenceWorkers            int                           `yaml:"numInferenceWorkers"`
+	InferenceApiBaseUrls           []InferenceEndpoint           `yaml:"inferenceApiBaseUrls"`
+	InferenceApiToken              string                        `yaml:"inferenceApiToken"`
+	ServerAddress                  string                        `yaml:"serverAddress"`
+	ServerPort                     string                        `yaml:"serverPort"`
+	ExpansionDepth                 int                           `yaml:"expansionIntermediaryNodes"`
+	PromptTemplates                map[string]string             `yaml:"promptTemplates"`
+	RegexTemplates                 map[string]string             `yaml:"regexTemplates"`
+	ExecutionContainers            map[string]ExecutionContainer `yaml:"executionContainers"`
+	ExecutionRunners               map[string]string             `yaml:"executionRunners"`
+	ComputeEfficientMode           bool                          `yaml:"useComputeEfficientMode"`
+	ApplyRegexInferenceOnly        bool                          `yaml:"applyRegexInferenceOnly"`
+	EarlyStopOnTranslationSuccess  bool                          `yaml:"earlyStop"`
+	UseTranscoderTestFormat        bool                          `yaml:"useTranscoderTestFormat"`
+	VerifyIntermediateTranslations bool                          `yaml:"verifyIntermediateTranslations"`
+	StopOnDirectTranslation        bool                          `yaml:"stopOnDirectTranslation"`
+	UseIntermediatesMemoization    bool                          `yaml:"useCrossPathIntermediatesMemoization"`
+	UseInferenceCache              bool                          `yaml:"useInferenceCache"`
+	UseResponseCache               bool                          `yaml:"useResponseCache"`
+	UseExecutionCache              bool                          `yaml:"useExecutionCache"`
+	MaxGeneratedTokens             int                           `yaml:"maxGeneratedTokens"`
+	TopP                           float32                       `yaml:"top-p"`
+	TopK                           int                           `yaml:"top-k"`
+	Temperature                    float32                       `yaml:"temperature"`
+	Seed                           int                           `yaml:"inferenceSeed"`
+	DatabasePath                   string                        `yaml:"cacheDatabasePath"`
+	InferenceBackend               string                        `yaml:"inferenceBackend"`
+	InferenceModels                map[string]InferenceModel     `yaml:"inferenceModels"`
+	CandidatesPerEdge              int                           `yaml:"candidatesPerEdge"`
+	SearchStrategy                 string                        `yaml:"searchStrategy"`
+	SearchScorer                   string                        `yaml:"searchScorer"`
+	SearchWidth                    int                           `yaml:"searchWidth"`
+	PathOrdering                   string                        `yaml:"pathOrdering"`
+	MaxRepairAttempts              int                           `yaml:"maxRepairAttempts"`
+	RepairPromptTemplate           string                        `yaml:"repairPromptTemplate"`
+	CodeExtraction                 string                        `yaml:"codeExtraction"`
+	CheckExtractedSyntax           bool                          `yaml:"checkExtractedSyntax"` // Only languages with an in-engine syntax checker (Go) are checked
+	SyntaxPreCheck                 bool                          `yaml:"syntaxPreCheck"`
+	EndpointFailureThreshold       int                           `yaml:"endpointFailureThreshold"`
+	EndpointProbeInterval          int                           `yaml:"endpointProbeInterval"`
+	PanEtAlRepairRounds            int                           `yaml:"panEtAlRepairRounds"`
+	PanEtAlRepairPromptTemplate    string                        `yaml:"panEtAlRepairPromptTemplate"`
+}
+
+// Inference server of a model. Zero values use openai_chat and inferenceApiBaseUrls
+type InferenceModel struct {
+	Provider             string              `yaml:"provider"`
+	BaseUrls             []InferenceEndpoint `yaml:"baseUrls"`
+	PromptTokenPrice     float64             `yaml:"promptTokenPrice"`     // Price per million prompt tokens, used to estimate the cost
+	CompletionTokenPrice float64             `yaml:"completionTokenPrice"` // Price per million generated tokens
+}
+
+// Server of the inference API. Endpoints with models only receive the requests of those models
+type InferenceEndpoint struct {
+	Url    string   `yaml:"url"`
+	Weight float64  `yaml:"weight"`
+	Models []string `yaml:"models"`
+}
+
+// Older configs only have the url of the endpoint, e.g. "http://localhost:8000/v1"
+func (endpoint *InferenceEndpoint) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		endpoint.Url = value.Value
+		return nil
+	}
+
+	type plainInferenceEndpoint InferenceEndpoint
+	return value.Decode((*plainInferenceEndpoint)(endpoint))
+}
+
+func (endpoint InferenceEndpoint) GetWeight() float64 {
+	if endpoint.Weight <= 0 {
+		return 1
+	}
+	return endpoint.Weight
+}
+
+func (endpoint InferenceEndpoint) Serves(modelName string) bool {
+	if len(endpoint.Models) == 0 {
+		return true
+	}
+
+	for _, model := range endpoint.Models {
+		if model == modelName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sandbox settings to execute the code of a language. Zero values use the defaults below
+type ExecutionContainer struct {
+	Image              string   `yaml:"image"`
+	Memory             string   `yaml:"memory"`
+	Cpus               float64  `yaml:"cpus"`
+	WallTimeout        int      `yaml:"wallTimeout"`
+	CompileTimeout     int      `yaml:"compileTimeout"`
+	OutputLimit        int      `yaml:"outputLimit"`
+	BindMounts         []string `yaml:"bindMounts"`
+	PromptPrefixes     []string `yaml:"promptPrefixes"`
+	SyntaxCheck        string   `yaml:"syntaxCheck"`        // Command that checks the syntax of {file} in the sandbox
+	SyntaxCheckTimeout int      `yaml:"syntaxCheckTimeout"` // Seconds before the syntax check is given up
+	BatchExecution     bool     `yaml:"batchExecution"`     // The script compiles once and runs every fuzzy test
+}
+
+const (
+	defaultContainerMemory      = "4G"
+	defaultContainerCpus        = 4
+	defaultContainerWallTimeout = 90
+	defaultContainerOutputLimit = 1024 * 1024 // 1 MB
+	defaultSyntaxCheckTimeout   = 10
+
+	defaultEndpointFailureThreshold = 3
+	defaultEndpointProbeInterval    = 30
+
+	defaultSearchWidth = 3
+)
+
+// Older configs only have the image of the language, e.g. "Python": "./singularity/img/python3.sif"
+func (container *ExecutionContainer) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		container.Image = value.Value
+		return nil
+	}
+
+	type plainExecutionContainer ExecutionContainer
+	return value.Decode((*plainExecutionContainer)(container))
+}
+
+func (container ExecutionContainer) GetMemory() string {
+	if container.Memory == "" {
+		return defaultContainerMemory
+	}
+	return container.Memory
+}
+
+func (container ExecutionContainer) GetCpus() float64 {
+	if container.Cpus <= 0 {
+		return defaultContainerCpus
+	}
+	return container.Cpus
+}
+
+// The code is compiled and executed by the same script of the container, so both budgets apply to each execution
+func (container ExecutionContainer) GetTimeout() time.Duration {
+	return container.GetWallTimeout() + time.Duration(max(container.CompileTimeout, 0))*time.Second
+}
+
+// Time a program can run, without compiling it
+func (container ExecutionContainer) GetWallTimeout() time.Duration {
+	if container.WallTimeout <= 0 {
+		return defaultContainerWallTimeout * time.Second
+	}
+	return time.Duration(container.WallTimeout) * time.Second
+}
+
+// Syntax checks only parse the code, so they get a much shorter timeout than the executions
+func (container ExecutionContainer) GetSyntaxCheckTimeout() time.Duration {
+	if container.SyntaxCheckTimeout <= 0 {
+		return defaultSyntaxCheckTimeout * time.Second
+	}
+	return time.Duration(container.SyntaxCheckTimeout) * time.Second
+}
+
+// Maximum allowed output of a program to prevent memory exhaustation
+func (container ExecutionContainer) GetOutputLimit() int {
+	if container.OutputLimit <= 0 {
+		return defaultContainerOutputLimit
+	}
+	return container.OutputLimit
+}
+
+// Number of sibling candidates generated for each edge of the ToCT with a single inference request
+func (config *AppConfig) GetCandidatesPerEdge() int {
+	if config.CandidatesPerEdge < 1 {
+		return 1
+	}
+	return config.CandidatesPerEdge
+}
+
+// Edges kept at each level by the beam search, or translated at once by the best-first search
+func (config *AppConfig) GetSearchWidth() int {
+	if config.SearchWidth < 1 {
+		return defaultSearchWidth
+	}
+	return config.SearchWidth
+}
+
+// Consecutive failures after which an inference endpoint stops receiving requests
+func (config *AppConfig) GetEndpointFailureThreshold() int {
+	if config.EndpointFailureThreshold < 1 {
+		return defaultEndpointFailureThreshold
+	}
+	return config.EndpointFailureThreshold
+}
+
+// Time before an ejected endpoint receives a probe request to check whether it is back
+func (config *AppConfig) GetEndpointProbeInterval() time.Duration {
+	probeInterval := config.EndpointProbeInterval
+
+	if probeInterval <= 0 {
+		probeInterval = defaultEndpointProbeInterval
+	}
+
+	return time.Duration(probeInterval) * time.Second
 }
 
 var ConfigStore AppConfig
@@ -222,22 +404,92 @@ func LoadExistingResponse(request *TranslationRequest) (TranslationResponse, boo
 }
 
 func GetResponseKey(request *TranslationRequest) string {
-	conf := strconv.Itoa(ConfigStore.ExpansionDepth) + strconv.FormatBool(ConfigStore.EarlyStopOnTranslationSuccess)
+	config := RequestConfig(request)
+	conf := strconv.Itoa(config.ExpansionDepth) + strconv.FormatBool(config.EarlyStopOnTranslationSuccess)
+
+	//Requests without overrides keep the keys of previous runs
+	if request.Overrides != nil {
+		overrides, _ := proto.MarshalOptions{Deterministic: true}.Marshal(request.Overrides)
+		conf = conf + string(overrides)
+	}
+
+	//Only the exhaustive search translates every path of the ToCT
+	if config.SearchStrategy != "" && config.SearchStrategy != "exhaustive" {
+		conf = conf + config.SearchStrategy + config.SearchScorer + strconv.Itoa(config.GetSearchWidth())
+	}
+
+	//With early stop, the order of the paths decides which of them are translated
+	if config.PathOrdering != "" && config.PathOrdering != "shallow_first" {
+		conf = conf + config.PathOrdering
+	}
+
+	if config.MaxRepairAttempts > 0 {
+		conf = conf + "repair" + strconv.Itoa(config.MaxRepairAttempts) + config.RepairPromptTemplate
+	}
+
+	if config.CodeExtraction != "" && config.CodeExtraction != "regex" {
+		conf = conf + config.CodeExtraction + strconv.FormatBool(config.CheckExtractedSyntax)
+	}
+
+	if config.SyntaxPreCheck {
+		conf = conf + "syntaxPreCheck"
+	}
+
+	//The candidates of each edge change the ToCT, even when set in the configuration instead of the overrides
+	if candidates := config.GetCandidatesPerEdge(); candidates > 1 {
+		conf = conf + "candidates" + strconv.Itoa(candidates)
+	}
+
+	conf = conf + providerKey(request.ModelName)
+
 	s := request.SeedLanguage + request.TargetLanguage + request.SeedCode + request.ModelName + request.PromptTemplateName + request.RegexTemplateName + request.Id + conf
 	hash := sha256.Sum256([]byte(s))
 	hashString := fmt.Sprintf("%x", hash)
 	return hashString
 }
 
+// Part of the keys given by the provider of the model. Models served by the default provider keep the keys of previous
+// runs
+func providerKey(modelName string) string {
+	if provider := ConfigStore.InferenceModels[modelName].Provider; provider != "" && provider != "openai_chat" {
+		return "provider" + provider
+	}
+
+	return ""
+}
+
 func GetExecutionKey(unit *ExecutionUnit) string {
 	s := unit.SourceCode + unit.Language + unit.StdinData + unit.ExecutedCode + unit.ExecutionType.String()
+
+	if unit.ExecutionType == BATCH {
+		s = s + strings.Join(unit.BatchStdinData, "\x00")
+	}
+
+	container := GetExecutorForLanguageMap()[unit.Language]
+	s = s + executionLimitsKey(container)
+
+	//The cached output has the prompts already stripped
+	if len(container.PromptPrefixes) > 0 {
+		s = s + "prompts" + strings.Join(container.PromptPrefixes, "\x00")
+	}
+
 	hash := sha256.Sum256([]byte(s))
 	hashString := fmt.Sprintf("%x", hash)
 	return hashString
 }
 
-func SaveInferenceResponseToCache(prompt string, modelName string, response InferenceResult) {
-	key := GetInferenceKey(prompt, modelName)
+// Timeouts and killed programs depend on the limits of the container, so results are only reused under the same
+// limits. Containers that keep the default limits keep the keys of previous runs
+func executionLimitsKey(container ExecutionContainer) string {
+	if container.Memory == "" && container.Cpus == 0 && container.WallTimeout == 0 && container.CompileTimeout == 0 && container.OutputLimit == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("limits%s/%g/%v/%v/%d", container.GetMemory(), container.GetCpus(), container.GetWallTimeout(), container.GetTimeout(), container.GetOutputLimit())
+}
+
+func SaveInferenceResponseToCache(prompt string, modelName string, samples int, config *AppConfig, response InferenceResult) {
+	key := GetInferenceKey(prompt, modelName, samples, config)
 
 	var buf bytes.Buffer
 	encoder := gob.NewEncoder(&buf)
@@ -257,8 +509,8 @@ func SaveInferenceResponseToCache(prompt string, modelName string, response Infe
 
 }
 
-func LoadInferenceExistingResponse(prompt string, modelName string) (InferenceResult, bool) {
-	key := GetInferenceKey(prompt, modelName)
+func LoadInferenceExistingResponse(prompt string, modelName string, samples int, config *AppConfig) (InferenceResult, bool) {
+	key := GetInferenceKey(prompt, modelName, samples, config)
 	var obj []byte
 
 	err := GetDatabase().View(func(txn *badger.Txn) error {
@@ -299,17 +551,26 @@ func LoadInferenceExistingResponse(prompt string, modelName string) (InferenceRe
 
 }
 
-func GetInferenceKey(prompt string, modelName string) string {
+// The sampling settings are part of the key, so requests that override them don't share cached inferences
+func GetInferenceKey(prompt string, modelName string, samples int, config *AppConfig) string {
 
 	//FIXME: Not good enough for general usage maybe
-	key := fmt.Sprintf("%s%s%d%t%f%f%d%d",
+	key := fmt.Sprintf("%s%s%d%f%f%d%d",
 		modelName,
 		prompt,
-		ConfigStore.MaxGeneratedTokens,
-		ConfigStore.Temperature,
-		ConfigStore.TopP,
-		ConfigStore.TopK,
-		ConfigStore.Seed)
+		config.MaxGeneratedTokens,
+		config.Temperature,
+		config.TopP,
+		config.TopK,
+		config.Seed)
+
+	//Single samples keep the keys they had before multiple samples were supported
+	if samples > 1 {
+		key = key + fmt.Sprintf("n%d", samples)
+	}
+
+	//Each provider maps the sampling settings differently, so their outputs are not interchangeable
+	key = key + providerKey(modelName)
 
 	hash := sha256.Sum256([]byte(key))
 	hashString := fmt.Sprintf("%x", hash)
@@ -346,10 +607,16 @@ func SaveBatchResponseToFile(baseFileName string, baseFilePath string, response
 }
 
 type InferenceResult struct {
-	Response string
-	IsCached bool
-	WallTime time.Duration
-	Success  bool
+	Response        string
+	Responses       []string // All the samples of a unit with N > 1. Response is the first one
+	Usage           TokenUsage
+	LogProbs        []float64 // Mean log-probability of the tokens of each sample, when requested
+	LogProb         float64   // Mean log-probability of the tokens of Response
+	IsCached        bool
+	WallTime        time.Duration
+	Success         bool
+	Cancelled       bool
+	BudgetExhausted bool // Not sent because a budget of the request or its batch was spent
 }
 
 // Define the Path struct that behaves like a list
@@ -375,6 +642,8 @@ const (
 	UNK ExecutionType = iota
 	TEST
 	RUN
+	SYNTAX_CHECK
+	BATCH
 )
 
 func (s ExecutionType) String() string {
@@ -383,6 +652,10 @@ func (s ExecutionType) String() string {
 		return "TEST"
 	case RUN:
 		return "RUN"
+	case SYNTAX_CHECK:
+		return "SYNTAX_CHECK"
+	case BATCH:
+		return "BATCH"
 	default:
 		panic("ExcutionType not found")
 	}
@@ -399,6 +672,39 @@ type ExecutionUnit struct {
 	ExecutionType      ExecutionType
 	WallTime           time.Duration
 	UsedExecutionCache bool
+	Cancelled          bool
+	BatchStdinData     []string     // Inputs of a BATCH execution, which runs the program once with each of them
+	CaseResults        []CaseResult // Results of a BATCH execution, in the order of its inputs
+	Phases             ExecutionPhases
+	Stdout             string // Stdout of the program before the prompts are stripped. Empty for a BATCH execution
+	Stderr             string // Stderr of the compiler and the program
+	ExitCode           int
+	PeakMemory         int64 // Bytes, 0 if the runner doesn't measure it
+
+	ctx context.Context // Unexported so it is not stored in the execution cache
+}
+
+// Result of running the program of a BATCH execution with one of its inputs
+type CaseResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	WallTime time.Duration
+	Output   string // Output that a RUN execution with the same input would have
+	Success  bool
+	Phases   ExecutionPhases
+}
+
+// Context of the request that needs this execution. The execution is stopped when it is cancelled
+func (unit *ExecutionUnit) Context() context.Context {
+	if unit.ctx == nil {
+		return context.Background()
+	}
+	return unit.ctx
+}
+
+func (unit *ExecutionUnit) SetContext(ctx context.Context) {
+	unit.ctx = ctx
 }
 
 type InferenceUnit struct {
@@ -406,6 +712,37 @@ type InferenceUnit struct {
 	ModelName     string
 	OutputChannel chan InferenceResult
 	WallTime      time.Duration
+	Config        *AppConfig // Optional, sampling settings of the request. ConfigStore is used by default
+	N             int        // Optional, number of samples generated for the prompt in a single request
+	LogProbs      bool       // Optional, returns the log-probabilities of the samples
+
+	ctx context.Context
+}
+
+// Context of the request that needs this inference. The inference is stopped when it is cancelled
+func (unit *InferenceUnit) Context() context.Context {
+	if unit.ctx == nil {
+		return context.Background()
+	}
+	return unit.ctx
+}
+
+func (unit *InferenceUnit) SetContext(ctx context.Context) {
+	unit.ctx = ctx
+}
+
+func (unit *InferenceUnit) GetSamples() int {
+	if unit.N < 1 {
+		return 1
+	}
+	return unit.N
+}
+
+func (unit *InferenceUnit) GetConfig() *AppConfig {
+	if unit.Config == nil {
+		return &ConfigStore
+	}
+	return unit.Config
 }
 
 type FuzzyTest struct {
@@ -415,16 +752,32 @@ type FuzzyTest struct {
 	Passed         bool
 	ExecutedCode   string
 	ExitCodeZero   bool
+	Comparator     *OutputComparator // Requested comparator, nil for the default one
+	ComparatorName string            // Comparator that decided the result
+	Phases         ExecutionPhases
+	Stdout         string
+	Stderr         string
+	ExitCode       int
+	WallTime       time.Duration
+	PeakMemory     int64 // Bytes, 0 if the runner doesn't measure it
 }
 
 func (unit *FuzzyTest) ToResponse() *ResponseFuzzyTestCase {
 
 	response := &ResponseFuzzyTestCase{
-		StdinInput:     unit.Input,
-		ExpectedOutput: unit.ExpectedOutput,
-		ActualOutput:   unit.ActualOutput,
-		Passed:         unit.Passed,
-		ExecutedCode:   unit.ExecutedCode,
+		StdinInput:      unit.Input,
+		ExpectedOutput:  unit.ExpectedOutput,
+		ActualOutput:    unit.ActualOutput,
+		Passed:          unit.Passed,
+		ExecutedCode:    unit.ExecutedCode,
+		Comparator:      unit.ComparatorName,
+		ExitCodeZero:    unit.ExitCodeZero,
+		Phases:          unit.Phases.ToResponse(),
+		Stdout:          unit.Stdout,
+		Stderr:          unit.Stderr,
+		ExitCode:        int32(unit.ExitCode),
+		WallTimeMs:      unit.WallTime.Milliseconds(),
+		PeakMemoryBytes: unit.PeakMemory,
 	}
 
 	return response
@@ -438,6 +791,14 @@ func FromResponseFuzzyTest(response *ResponseFuzzyTestCase) FuzzyTest {
 		ActualOutput:   response.ActualOutput,
 		Passed:         response.Passed,
 		ExecutedCode:   response.ExecutedCode,
+		ComparatorName: response.Comparator,
+		ExitCodeZero:   response.ExitCodeZero,
+		Phases:         FromResponsePhases(response.Phases),
+		Stdout:         response.Stdout,
+		Stderr:         response.Stderr,
+		ExitCode:       int(response.ExitCode),
+		WallTime:       time.Duration(response.WallTimeMs) * time.Millisecond,
+		PeakMemory:     response.PeakMemoryBytes,
 	}
 }
 
@@ -448,15 +809,28 @@ type UnitTest struct {
 	Passed       bool
 	Imports      string
 	ExitCodeZero bool
+	Phases       ExecutionPhases
+	Stdout       string
+	Stderr       string
+	ExitCode     int
+	WallTime     time.Duration
+	PeakMemory   int64 // Bytes, 0 if the runner doesn't measure it
 }
 
 func (unit *UnitTest) ToResponse() *ResponseUnitTestCase {
 
 	response := ResponseUnitTestCase{
-		SourceCode:   unit.SourceCode,
-		ActualOutput: unit.ActualOutput,
-		Passed:       unit.Passed,
-		ExecutedCode: unit.ExecutedCode,
+		SourceCode:      unit.SourceCode,
+		ActualOutput:    unit.ActualOutput,
+		Passed:          unit.Passed,
+		ExecutedCode:    unit.ExecutedCode,
+		ExitCodeZero:    unit.ExitCodeZero,
+		Phases:          unit.Phases.ToResponse(),
+		Stdout:          unit.Stdout,
+		Stderr:          unit.Stderr,
+		ExitCode:        int32(unit.ExitCode),
+		WallTimeMs:      unit.WallTime.Milliseconds(),
+		PeakMemoryBytes: unit.PeakMemory,
 	}
 
 	return &response
@@ -468,6 +842,49 @@ func FromResponseUnitTest(response *ResponseUnitTestCase) UnitTest {
 		ActualOutput: response.ActualOutput,
 		Passed:       response.Passed,
 		ExecutedCode: response.ExecutedCode,
+		ExitCodeZero: response.ExitCodeZero,
+		Phases:       FromResponsePhases(response.Phases),
+		Stdout:       response.Stdout,
+		Stderr:       response.Stderr,
+		ExitCode:     int(response.ExitCode),
+		WallTime:     time.Duration(response.WallTimeMs) * time.Millisecond,
+		PeakMemory:   response.PeakMemoryBytes,
+	}
+}
+
+// How far an execution got, as reported by the script of the language. Scripts that don't report their phases
+// are never Started, so their failures can't be told apart
+type ExecutionPhases struct {
+	CompileFailed   bool
+	CompileOutput   string // Output of the compiler, also when it succeeded with warnings
+	Started         bool   // The program was compiled and started running
+	RuntimeExitCode int
+	Signal          string // Signal that killed the program, if any
+	RuntimeStderr   string
+	TimedOut        bool
+}
+
+func (phases ExecutionPhases) ToResponse() *ResponseExecutionPhases {
+	return &ResponseExecutionPhases{
+		CompileFailed:   phases.CompileFailed,
+		CompileOutput:   phases.CompileOutput,
+		Started:         phases.Started,
+		RuntimeExitCode: int32(phases.RuntimeExitCode),
+		Signal:          phases.Signal,
+		RuntimeStderr:   phases.RuntimeStderr,
+		TimedOut:        phases.TimedOut,
+	}
+}
+
+func FromResponsePhases(response *ResponseExecutionPhases) ExecutionPhases {
+	return ExecutionPhases{
+		CompileFailed:   response.GetCompileFailed(),
+		CompileOutput:   response.GetCompileOutput(),
+		Started:         response.GetStarted(),
+		RuntimeExitCode: int(response.GetRuntimeExitCode()),
+		Signal:          response.GetSignal(),
+		RuntimeStderr:   response.GetRuntimeStderr(),
+		TimedOut:        response.GetTimedOut(),
 	}
 }
 
@@ -561,6 +978,11 @@ const (
 	FAILED_EXECUTION
 	FAILED_VERIFICATION
 	FAILED_EXECUTION_TIMEOUT
+	CANCELLED
+	BUDGET_EXHAUSTED
+	FAILED_SYNTAX
+	FAILED_COMPILATION
+	FAILED_RUNTIME
 )
 
 // String method to convert Status to string
@@ -592,6 +1014,16 @@ func (s Status) String() string {
 		return "FAILED_EXECUTION_TIMEOUT"
 	case TRANSLATED:
 		return "TRANSLATED"
+	case CANCELLED:
+		return "CANCELLED"
+	case BUDGET_EXHAUSTED:
+		return "BUDGET_EXHAUSTED"
+	case FAILED_SYNTAX:
+		return "FAILED_SYNTAX"
+	case FAILED_COMPILATION:
+		return "FAILED_COMPILATION"
+	case FAILED_RUNTIME:
+		return "FAILED_RUNTIME"
 	default:
 		return fmt.Sprintf("Unknown Status (%d)", s)
 	}
@@ -625,6 +1057,16 @@ func ParseStatus(status string) Status {
 		return SKIPPED_TRANSLATION_FOUND
 	case "TRANSLATION_FOUND":
 		return TRANSLATION_FOUND
+	case "CANCELLED":
+		return CANCELLED
+	case "BUDGET_EXHAUSTED":
+		return BUDGET_EXHAUSTED
+	case "FAILED_SYNTAX":
+		return FAILED_SYNTAX
+	case "FAILED_COMPILATION":
+		return FAILED_COMPILATION
+	case "FAILED_RUNTIME":
+		return FAILED_RUNTIME
 	default:
 		panic("Unknown status")
 	}
@@ -660,25 +1102,120 @@ type TranslationEdge struct {
 	UsedMemoization            bool
 	UsedInferenceCache         bool
 	ExtraPromptData            string
+	ErrorFeedback              string
+	Usage                      TokenUsage                  // Tokens of the inference of this edge. Only the first sample of a group counts them
+	LogProb                    float64                     // Mean log-probability of the tokens of the translation, when requested
+	Config                     *AppConfig                  // Optional, settings of the request after its overrides. ConfigStore is used by default
+	SampleGroup                *SampleGroup                // Optional, siblings that share a single inference with several samples
+	SampleIndex                int                         // Sample of the group used by this edge
+	Budget                     *BudgetTracker              // Optional, budget of the request charged for the inferences and executions
+	OnStatusChange             func(edge *TranslationEdge) // Optional, called after the status changes
+	RepairAttempt              int                         // Number of the repair attempt of the parent edge, 0 for translations
+	RepairEdges                []*TranslationEdge          // Repair attempts made after this edge failed, in order
+	ExtractionStrategy         string                      // How the code was found in the inference output
 
 	status          Status      // Status property
 	StatusMutex     *sync.Mutex // Mutex for thread safety
 	ProcessingMutex *sync.Mutex
 }
 
+func (e *TranslationEdge) GetConfig() *AppConfig {
+	if e.Config == nil {
+		return &ConfigStore
+	}
+	return e.Config
+}
+
+// Sibling edges with the same prompt that share the samples of a single inference request
+type SampleGroup struct {
+	Size    int
+	once    sync.Once
+	started atomic.Bool
+	result  InferenceResult
+}
+
+func NewSampleGroup(size int) *SampleGroup {
+	return &SampleGroup{Size: size}
+}
+
+// The first edge of the group to be processed performs the inference for all of them. Returns whether the caller
+// was that edge
+func (group *SampleGroup) Result(infer func(samples int) InferenceResult) (InferenceResult, bool) {
+	performed := false
+
+	group.once.Do(func() {
+		group.started.Store(true)
+		group.result = infer(group.Size)
+		performed = true
+	})
+
+	return group.result, performed
+}
+
+// Whether an edge of the group already requested the samples, which the other edges can use without a new inference
+func (group *SampleGroup) Started() bool {
+	return group != nil && group.started.Load()
+}
+
+// Result of a single sample. The server may return fewer samples than requested. The tokens of the request are only
+// kept in the sample of the edge that performed it, so that the totals count them once
+func (result InferenceResult) Sample(index int, performed bool) InferenceResult {
+	if !performed {
+		result.Usage = TokenUsage{}
+	}
+
+	if !result.Success || result.Cancelled {
+		return result
+	}
+
+	if index >= len(result.Responses) {
+		result.Response = ""
+		result.Success = false
+	} else {
+		result.Response = result.Responses[index]
+	}
+
+	if index < len(result.LogProbs) {
+		result.LogProb = result.LogProbs[index]
+	}
+
+	result.Responses = nil
+	result.LogProbs = nil
+
+	return result
+}
+
 // Setter method for Status
 func (e *TranslationEdge) SetStatus(newStatus Status) {
 	e.StatusMutex.Lock()
-	defer e.StatusMutex.Unlock()
+	changed := e.status != newStatus
 	e.status = newStatus
+	e.StatusMutex.Unlock()
+
+	if changed {
+		e.notifyStatusChange()
+	}
 }
 
 func (e *TranslationEdge) UpdatePendingStatus(newStatus Status) {
 	e.StatusMutex.Lock()
-	defer e.StatusMutex.Unlock()
+	changed := false
 	if e.status == PENDING || e.status == PROCESSING {
+		changed = e.status != newStatus
 		e.status = newStatus
 	}
+	e.StatusMutex.Unlock()
+
+	if changed {
+		e.notifyStatusChange()
+	}
+}
+
+// The listener is called without holding the status mutex so it can read the status back
+func (e *TranslationEdge) notifyStatusChange() {
+	if e.OnStatusChange != nil {
+		e.OnStatusChange(e)
+	}
 }
 
 // Getter method for Status
@@ -688,6 +1225,33 @@ func (e *TranslationEdge) GetStatus() Status {
 	return e.status
 }
 
+// Categories of tests reported in failed_test_categories
+const (
+	FUZZY_TEST_CATEGORY = "FUZZY"
+	UNIT_TEST_CATEGORY  = "UNIT"
+)
+
+// Categories with at least one executed test that did not pass. Tests that were not executed are ignored
+func (e *TranslationEdge) FailedTestCategories() []string {
+	categories := []string{}
+
+	for _, test := range e.FuzzyTests {
+		if test.ExecutedCode != "" && !test.Passed {
+			categories = append(categories, FUZZY_TEST_CATEGORY)
+			break
+		}
+	}
+
+	for _, test := range e.UnitTests {
+		if test.ExecutedCode != "" && !test.Passed {
+			categories = append(categories, UNIT_TEST_CATEGORY)
+			break
+		}
+	}
+
+	return categories
+}
+
 // Implement the Stringer interface for TranslationEdge
 func (e TranslationEdge) String() string {
 	return fmt.Sprintf("%s -> %s", e.InputLanguage, e.TargetLanguage)
@@ -705,6 +1269,37 @@ func (te *TranslationEdge) GetDepth() int {
 	return counter
 }
 
+// The last repair attempt of the edge, or the edge itself if it was not repaired. The children of the edge continue
+// from its code and status
+func (te *TranslationEdge) Resolved() *TranslationEdge {
+	if len(te.RepairEdges) == 0 {
+		return te
+	}
+
+	return te.RepairEdges[len(te.RepairEdges)-1]
+}
+
+// The translation that a repair edge and the attempts before it repair. Edges that are not repairs return themselves
+func (te *TranslationEdge) RepairedTranslation() *TranslationEdge {
+	currentEdge := te
+
+	for currentEdge.RepairAttempt > 0 && currentEdge.ParentEdge != nil {
+		currentEdge = currentEdge.ParentEdge
+	}
+
+	return currentEdge
+}
+
+func (te *TranslationEdge) GetRootEdge() *TranslationEdge {
+	currentEdge := te
+
+	for currentEdge.ParentEdge != nil {
+		currentEdge = currentEdge.ParentEdge
+	}
+
+	return currentEdge
+}
+
 func (te *TranslationEdge) IsDirectPathToTarget(targetLanguage string) bool {
 	directPathToTarget := true
 	currentEdge := te
@@ -769,6 +1364,6 @@ func GetFileExtensionsMap() map[string]string {
 	return fileExtensionsMap
 }
 
-func GetExecutorForLanguageMap() map[string]string {
+func GetExecutorForLanguageMap() map[string]ExecutionContainer {
 	return ConfigStore.ExecutionContainers
 }
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dgraph-io/badger/v4"
+	"google.golang.org/protobuf/proto"
+)
+
+// Jobs share the cache database with the other caches. The prefixes keep them apart from the sha256 cache keys
+const (
+	jobStatusPrefix = "job/"
+	jobResultPrefix = "job_result/"
+)
+
+func SaveJobStatus(job *JobStatus) error {
+	data, err := proto.Marshal(job)
+
+	if err != nil {
+		return fmt.Errorf("failed to serialize job status: %w", err)
+	}
+
+	return db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(jobStatusPrefix+job.JobId), data)
+	})
+}
+
+func LoadJobStatus(jobId string) (*JobStatus, bool) {
+	job := &JobStatus{}
+
+	if loadProtoFromDatabase(jobStatusPrefix+jobId, job) {
+		return nil, true
+	}
+
+	return job, false
+}
+
+func SaveJobResult(jobId string, response *BatchTranslationResponse) error {
+	data, err := proto.Marshal(response)
+
+	if err != nil {
+		return fmt.Errorf("failed to serialize job result: %w", err)
+	}
+
+	return db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(jobResultPrefix+jobId), data)
+	})
+}
+
+func LoadJobResult(jobId string) (*BatchTranslationResponse, bool) {
+	response := &BatchTranslationResponse{}
+
+	if loadProtoFromDatabase(jobResultPrefix+jobId, response) {
+		return nil, true
+	}
+
+	return response, false
+}
+
+// Returns all the jobs stored in the database
+func LoadAllJobStatuses() []*JobStatus {
+	jobs := []*JobStatus{}
+
+	err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		prefix := []byte(jobStatusPrefix)
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			err := it.Item().Value(func(val []byte) error {
+				job := &JobStatus{}
+
+				if err := proto.Unmarshal(val, job); err != nil {
+					return err
+				}
+
+				jobs = append(jobs, job)
+				return nil
+			})
+
+			if err != nil {
+				fmt.Printf("Error decoding job %s\n", strings.TrimPrefix(string(it.Item().Key()), jobStatusPrefix))
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		fmt.Println("Error reading jobs from database")
+	}
+
+	return jobs
+}
+
+func loadProtoFromDatabase(key string, message proto.Message) bool {
+	var obj []byte
+
+	err := db.View(func(txn *badger.Txn) error {
+
+		item, err := txn.Get([]byte(key))
+
+		if err != nil {
+			return err
+		}
+
+		return item.Value(func(val []byte) error {
+			obj = append([]byte{}, val...)
+			return nil
+		})
+	})
+
+	if err != nil {
+		return true
+	}
+
+	if err := proto.Unmarshal(obj, message); err != nil {
+		fmt.Printf("Error decoding %s from database\n", key)
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Settings used to process a request: the loaded configuration with the overrides of the request applied
+func RequestConfig(request *TranslationRequest) *AppConfig {
+	config := ApplyOverrides(ConfigStore, request.GetOverrides())
+	return &config
+}
+
+// Returns a copy of the configuration. Only the fields set in the overrides are replaced
+func ApplyOverrides(config AppConfig, overrides *ConfigOverrides) AppConfig {
+	if overrides == nil {
+		return config
+	}
+
+	if overrides.ExpansionDepth != nil {
+		config.ExpansionDepth = int(overrides.ExpansionDepth.Value)
+	}
+
+	if overrides.EarlyStop != nil {
+		config.EarlyStopOnTranslationSuccess = overrides.EarlyStop.Value
+	}
+
+	if overrides.VerifyIntermediateTranslations != nil {
+		config.VerifyIntermediateTranslations = overrides.VerifyIntermediateTranslations.Value
+	}
+
+	if overrides.ComputeEfficientMode != nil {
+		config.ComputeEfficientMode = overrides.ComputeEfficientMode.Value
+	}
+
+	if overrides.MaxGeneratedTokens != nil {
+		config.MaxGeneratedTokens = int(overrides.MaxGeneratedTokens.Value)
+	}
+
+	if overrides.Temperature != nil {
+		config.Temperature = float32(overrides.Temperature.Value)
+	}
+
+	if overrides.TopP != nil {
+		config.TopP = float32(overrides.TopP.Value)
+	}
+
+	if overrides.TopK != nil {
+		config.TopK = int(overrides.TopK.Value)
+	}
+
+	if overrides.Seed != nil {
+		config.Seed = int(overrides.Seed.Value)
+	}
+
+	if overrides.PanEtAlRepairRounds != nil {
+		config.PanEtAlRepairRounds = int(overrides.PanEtAlRepairRounds.Value)
+	}
+
+	if overrides.CandidatesPerEdge != nil {
+		config.CandidatesPerEdge = int(overrides.CandidatesPerEdge.Value)
+	}
+
+	if overrides.SearchStrategy != nil {
+		config.SearchStrategy = overrides.SearchStrategy.Value
+	}
+
+	if overrides.SearchScorer != nil {
+		config.SearchScorer = overrides.SearchScorer.Value
+	}
+
+	if overrides.SearchWidth != nil {
+		config.SearchWidth = int(overrides.SearchWidth.Value)
+	}
+
+	if overrides.PathOrdering != nil {
+		config.PathOrdering = overrides.PathOrdering.Value
+	}
+
+	if overrides.MaxRepairAttempts != nil {
+		config.MaxRepairAttempts = int(overrides.MaxRepairAttempts.Value)
+	}
+
+	if overrides.CodeExtraction != nil {
+		config.CodeExtraction = overrides.CodeExtraction.Value
+	}
+
+	if overrides.CheckExtractedSyntax != nil {
+		config.CheckExtractedSyntax = overrides.CheckExtractedSyntax.Value
+	}
+
+	if overrides.SyntaxPreCheck != nil {
+		config.SyntaxPreCheck = overrides.SyntaxPreCheck.Value
+	}
+
+	return config
+}
+
+// The overrides of the batch apply to every request of the batch, unless the request overrides the same field
+func ApplyBatchOverrides(batchRequest *BatchTranslationRequest) {
+	if batchRequest.Overrides == nil {
+		return
+	}
+
+	for _, request := range batchRequest.TranslationRequests {
+		merged := proto.Clone(batchRequest.Overrides).(*ConfigOverrides)
+
+		//proto.Merge would keep the value of the batch when the request sets a field to its zero value
+		if request.Overrides != nil {
+			target := merged.ProtoReflect()
+
+			request.Overrides.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+				target.Set(field, value)
+				return true
+			})
+		}
+
+		request.Overrides = merged
+	}
+}
@@ -30,6 +30,7 @@ const (
 	ResponseStatus_TRANSLATION_FOUND         ResponseStatus = 4
 	ResponseStatus_SKIPPED_PARENT_FAILED     ResponseStatus = 5
 	ResponseStatus_SKIPPED_TRANSLATION_FOUND ResponseStatus = 6
+	ResponseStatus_CANCELLED                 ResponseStatus = 7
 )
 
 // Enum value maps for ResponseStatus.
@@ -42,6 +43,7 @@ var (
 		4: "TRANSLATION_FOUND",
 		5: "SKIPPED_PARENT_FAILED",
 		6: "SKIPPED_TRANSLATION_FOUND",
+		7: "CANCELLED",
 	}
 	ResponseStatus_value = map[string]int32{
 		"PENDING":                   0,
@@ -51,6 +53,7 @@ var (
 		"TRANSLATION_FOUND":         4,
 		"SKIPPED_PARENT_FAILED":     5,
 		"SKIPPED_TRANSLATION_FOUND": 6,
+		"CANCELLED":                 7,
 	}
 )
 
@@ -86,8 +89,9 @@ type TestSuite struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FuzzySuite    []*FuzzyTestCase `protobuf:"bytes,1,rep,name=fuzzy_suite,json=fuzzySuite,proto3" json:"fuzzy_suite,omitempty"`
-	UnitTestSuite []*UnitTestCase  `protobuf:"bytes,2,rep,name=unit_test_suite,json=unitTestSuite,proto3" json:"unit_test_suite,omitempty"`
+	FuzzySuite      []*FuzzyTestCase  `protobuf:"bytes,1,rep,name=fuzzy_suite,json=fuzzySuite,proto3" json:"fuzzy_suite,omitempty"`
+	UnitTestSuite   []*UnitTestCase   `protobuf:"bytes,2,rep,name=unit_test_suite,json=unitTestSuite,proto3" json:"unit_test_suite,omitempty"`
+	FuzzyComparator *OutputComparator `protobuf:"bytes,3,opt,name=fuzzy_comparator,json=fuzzyComparator,proto3" json:"fuzzy_comparator,omitempty"`
 }
 
 func (x *TestSuite) Reset() {
@@ -136,19 +140,90 @@ func (x *TestSuite) GetUnitTestSuite() []*UnitTestCase {
 	return nil
 }
 
+func (x *TestSuite) GetFuzzyComparator() *OutputComparator {
+	if x != nil {
+		return x.FuzzyComparator
+	}
+	return nil
+}
+
+type OutputComparator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AbsoluteTolerance float64 `protobuf:"fixed64,2,opt,name=absolute_tolerance,json=absoluteTolerance,proto3" json:"absolute_tolerance,omitempty"`
+	RelativeTolerance float64 `protobuf:"fixed64,3,opt,name=relative_tolerance,json=relativeTolerance,proto3" json:"relative_tolerance,omitempty"`
+}
+
+func (x *OutputComparator) Reset() {
+	*x = OutputComparator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputComparator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputComparator) ProtoMessage() {}
+
+func (x *OutputComparator) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputComparator.ProtoReflect.Descriptor instead.
+func (*OutputComparator) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OutputComparator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OutputComparator) GetAbsoluteTolerance() float64 {
+	if x != nil {
+		return x.AbsoluteTolerance
+	}
+	return 0
+}
+
+func (x *OutputComparator) GetRelativeTolerance() float64 {
+	if x != nil {
+		return x.RelativeTolerance
+	}
+	return 0
+}
+
 type FuzzyTestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StdinInput     string `protobuf:"bytes,1,opt,name=stdin_input,json=stdinInput,proto3" json:"stdin_input,omitempty"`
-	ExpectedOutput string `protobuf:"bytes,2,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	StdinInput     string            `protobuf:"bytes,1,opt,name=stdin_input,json=stdinInput,proto3" json:"stdin_input,omitempty"`
+	ExpectedOutput string            `protobuf:"bytes,2,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	Comparator     *OutputComparator `protobuf:"bytes,3,opt,name=comparator,proto3" json:"comparator,omitempty"`
 }
 
 func (x *FuzzyTestCase) Reset() {
 	*x = FuzzyTestCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[1]
+		mi := &file_protos_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -161,7 +236,7 @@ func (x *FuzzyTestCase) String() string {
 func (*FuzzyTestCase) ProtoMessage() {}
 
 func (x *FuzzyTestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[1]
+	mi := &file_protos_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +249,7 @@ func (x *FuzzyTestCase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuzzyTestCase.ProtoReflect.Descriptor instead.
 func (*FuzzyTestCase) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{1}
+	return file_protos_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *FuzzyTestCase) GetStdinInput() string {
@@ -191,22 +266,37 @@ func (x *FuzzyTestCase) GetExpectedOutput() string {
 	return ""
 }
 
+func (x *FuzzyTestCase) GetComparator() *OutputComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return nil
+}
+
 type ResponseFuzzyTestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StdinInput     string `protobuf:"bytes,1,opt,name=stdin_input,json=stdinInput,proto3" json:"stdin_input,omitempty"`
-	ExpectedOutput string `protobuf:"bytes,2,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
-	ActualOutput   string `protobuf:"bytes,3,opt,name=actual_output,json=actualOutput,proto3" json:"actual_output,omitempty"`
-	Passed         bool   `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
-	ExecutedCode   string `protobuf:"bytes,5,opt,name=executed_code,json=executedCode,proto3" json:"executed_code,omitempty"`
+	StdinInput      string                   `protobuf:"bytes,1,opt,name=stdin_input,json=stdinInput,proto3" json:"stdin_input,omitempty"`
+	ExpectedOutput  string                   `protobuf:"bytes,2,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	ActualOutput    string                   `protobuf:"bytes,3,opt,name=actual_output,json=actualOutput,proto3" json:"actual_output,omitempty"`
+	Passed          bool                     `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	ExecutedCode    string                   `protobuf:"bytes,5,opt,name=executed_code,json=executedCode,proto3" json:"executed_code,omitempty"`
+	Comparator      string                   `protobuf:"bytes,6,opt,name=comparator,proto3" json:"comparator,omitempty"`
+	ExitCodeZero    bool                     `protobuf:"varint,7,opt,name=exit_code_zero,json=exitCodeZero,proto3" json:"exit_code_zero,omitempty"`
+	Phases          *ResponseExecutionPhases `protobuf:"bytes,8,opt,name=phases,proto3" json:"phases,omitempty"`
+	Stdout          string                   `protobuf:"bytes,9,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr          string                   `protobuf:"bytes,10,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ExitCode        int32                    `protobuf:"varint,11,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	WallTimeMs      int64                    `protobuf:"varint,12,opt,name=wall_time_ms,json=wallTimeMs,proto3" json:"wall_time_ms,omitempty"`
+	PeakMemoryBytes int64                    `protobuf:"varint,13,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3" json:"peak_memory_bytes,omitempty"`
 }
 
 func (x *ResponseFuzzyTestCase) Reset() {
 	*x = ResponseFuzzyTestCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[2]
+		mi := &file_protos_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -219,7 +309,7 @@ func (x *ResponseFuzzyTestCase) String() string {
 func (*ResponseFuzzyTestCase) ProtoMessage() {}
 
 func (x *ResponseFuzzyTestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[2]
+	mi := &file_protos_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +322,7 @@ func (x *ResponseFuzzyTestCase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseFuzzyTestCase.ProtoReflect.Descriptor instead.
 func (*ResponseFuzzyTestCase) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{2}
+	return file_protos_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ResponseFuzzyTestCase) GetStdinInput() string {
@@ -270,21 +360,84 @@ func (x *ResponseFuzzyTestCase) GetExecutedCode() string {
 	return ""
 }
 
+func (x *ResponseFuzzyTestCase) GetComparator() string {
+	if x != nil {
+		return x.Comparator
+	}
+	return ""
+}
+
+func (x *ResponseFuzzyTestCase) GetExitCodeZero() bool {
+	if x != nil {
+		return x.ExitCodeZero
+	}
+	return false
+}
+
+func (x *ResponseFuzzyTestCase) GetPhases() *ResponseExecutionPhases {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *ResponseFuzzyTestCase) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *ResponseFuzzyTestCase) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *ResponseFuzzyTestCase) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ResponseFuzzyTestCase) GetWallTimeMs() int64 {
+	if x != nil {
+		return x.WallTimeMs
+	}
+	return 0
+}
+
+func (x *ResponseFuzzyTestCase) GetPeakMemoryBytes() int64 {
+	if x != nil {
+		return x.PeakMemoryBytes
+	}
+	return 0
+}
+
 type ResponseUnitTestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceCode   string `protobuf:"bytes,1,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
-	ActualOutput string `protobuf:"bytes,2,opt,name=actual_output,json=actualOutput,proto3" json:"actual_output,omitempty"`
-	Passed       bool   `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
-	ExecutedCode string `protobuf:"bytes,4,opt,name=executed_code,json=executedCode,proto3" json:"executed_code,omitempty"`
+	SourceCode      string                   `protobuf:"bytes,1,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	ActualOutput    string                   `protobuf:"bytes,2,opt,name=actual_output,json=actualOutput,proto3" json:"actual_output,omitempty"`
+	Passed          bool                     `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	ExecutedCode    string                   `protobuf:"bytes,4,opt,name=executed_code,json=executedCode,proto3" json:"executed_code,omitempty"`
+	ExitCodeZero    bool                     `protobuf:"varint,5,opt,name=exit_code_zero,json=exitCodeZero,proto3" json:"exit_code_zero,omitempty"`
+	Phases          *ResponseExecutionPhases `protobuf:"bytes,6,opt,name=phases,proto3" json:"phases,omitempty"`
+	Stdout          string                   `protobuf:"bytes,7,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr          string                   `protobuf:"bytes,8,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ExitCode        int32                    `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	WallTimeMs      int64                    `protobuf:"varint,10,opt,name=wall_time_ms,json=wallTimeMs,proto3" json:"wall_time_ms,omitempty"`
+	PeakMemoryBytes int64                    `protobuf:"varint,11,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3" json:"peak_memory_bytes,omitempty"`
 }
 
 func (x *ResponseUnitTestCase) Reset() {
 	*x = ResponseUnitTestCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[3]
+		mi := &file_protos_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -297,7 +450,7 @@ func (x *ResponseUnitTestCase) String() string {
 func (*ResponseUnitTestCase) ProtoMessage() {}
 
 func (x *ResponseUnitTestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[3]
+	mi := &file_protos_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +463,7 @@ func (x *ResponseUnitTestCase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseUnitTestCase.ProtoReflect.Descriptor instead.
 func (*ResponseUnitTestCase) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{3}
+	return file_protos_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ResponseUnitTestCase) GetSourceCode() string {
@@ -341,6 +494,150 @@ func (x *ResponseUnitTestCase) GetExecutedCode() string {
 	return ""
 }
 
+func (x *ResponseUnitTestCase) GetExitCodeZero() bool {
+	if x != nil {
+		return x.ExitCodeZero
+	}
+	return false
+}
+
+func (x *ResponseUnitTestCase) GetPhases() *ResponseExecutionPhases {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *ResponseUnitTestCase) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *ResponseUnitTestCase) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *ResponseUnitTestCase) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ResponseUnitTestCase) GetWallTimeMs() int64 {
+	if x != nil {
+		return x.WallTimeMs
+	}
+	return 0
+}
+
+func (x *ResponseUnitTestCase) GetPeakMemoryBytes() int64 {
+	if x != nil {
+		return x.PeakMemoryBytes
+	}
+	return 0
+}
+
+type ResponseExecutionPhases struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompileFailed   bool   `protobuf:"varint,1,opt,name=compile_failed,json=compileFailed,proto3" json:"compile_failed,omitempty"`
+	CompileOutput   string `protobuf:"bytes,2,opt,name=compile_output,json=compileOutput,proto3" json:"compile_output,omitempty"`
+	Started         bool   `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	RuntimeExitCode int32  `protobuf:"varint,4,opt,name=runtime_exit_code,json=runtimeExitCode,proto3" json:"runtime_exit_code,omitempty"`
+	Signal          string `protobuf:"bytes,5,opt,name=signal,proto3" json:"signal,omitempty"`
+	RuntimeStderr   string `protobuf:"bytes,6,opt,name=runtime_stderr,json=runtimeStderr,proto3" json:"runtime_stderr,omitempty"`
+	TimedOut        bool   `protobuf:"varint,7,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+}
+
+func (x *ResponseExecutionPhases) Reset() {
+	*x = ResponseExecutionPhases{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseExecutionPhases) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseExecutionPhases) ProtoMessage() {}
+
+func (x *ResponseExecutionPhases) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseExecutionPhases.ProtoReflect.Descriptor instead.
+func (*ResponseExecutionPhases) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResponseExecutionPhases) GetCompileFailed() bool {
+	if x != nil {
+		return x.CompileFailed
+	}
+	return false
+}
+
+func (x *ResponseExecutionPhases) GetCompileOutput() string {
+	if x != nil {
+		return x.CompileOutput
+	}
+	return ""
+}
+
+func (x *ResponseExecutionPhases) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *ResponseExecutionPhases) GetRuntimeExitCode() int32 {
+	if x != nil {
+		return x.RuntimeExitCode
+	}
+	return 0
+}
+
+func (x *ResponseExecutionPhases) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *ResponseExecutionPhases) GetRuntimeStderr() string {
+	if x != nil {
+		return x.RuntimeStderr
+	}
+	return ""
+}
+
+func (x *ResponseExecutionPhases) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
 type UnitTestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -354,7 +651,7 @@ type UnitTestCase struct {
 func (x *UnitTestCase) Reset() {
 	*x = UnitTestCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[4]
+		mi := &file_protos_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -367,7 +664,7 @@ func (x *UnitTestCase) String() string {
 func (*UnitTestCase) ProtoMessage() {}
 
 func (x *UnitTestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[4]
+	mi := &file_protos_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +677,7 @@ func (x *UnitTestCase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnitTestCase.ProtoReflect.Descriptor instead.
 func (*UnitTestCase) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{4}
+	return file_protos_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UnitTestCase) GetLanguage() string {
@@ -416,7 +713,7 @@ type TargetSignature struct {
 func (x *TargetSignature) Reset() {
 	*x = TargetSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[5]
+		mi := &file_protos_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -429,7 +726,7 @@ func (x *TargetSignature) String() string {
 func (*TargetSignature) ProtoMessage() {}
 
 func (x *TargetSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[5]
+	mi := &file_protos_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +739,7 @@ func (x *TargetSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetSignature.ProtoReflect.Descriptor instead.
 func (*TargetSignature) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{5}
+	return file_protos_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TargetSignature) GetLanguage() string {
@@ -475,12 +772,14 @@ type TranslationRequest struct {
 	RegexTemplateName  string             `protobuf:"bytes,9,opt,name=regex_template_name,json=regexTemplateName,proto3" json:"regex_template_name,omitempty"`
 	ModelName          string             `protobuf:"bytes,10,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
 	ExtraPromptData    string             `protobuf:"bytes,11,opt,name=extra_prompt_data,json=extraPromptData,proto3" json:"extra_prompt_data,omitempty"`
+	Overrides          *ConfigOverrides   `protobuf:"bytes,12,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	Budget             *Budget            `protobuf:"bytes,13,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
 func (x *TranslationRequest) Reset() {
 	*x = TranslationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[6]
+		mi := &file_protos_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -493,7 +792,7 @@ func (x *TranslationRequest) String() string {
 func (*TranslationRequest) ProtoMessage() {}
 
 func (x *TranslationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[6]
+	mi := &file_protos_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +805,7 @@ func (x *TranslationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TranslationRequest.ProtoReflect.Descriptor instead.
 func (*TranslationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{6}
+	return file_protos_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TranslationRequest) GetId() string {
@@ -586,23 +885,487 @@ func (x *TranslationRequest) GetExtraPromptData() string {
 	return ""
 }
 
-type ResponseTranslationEdge struct {
+func (x *TranslationRequest) GetOverrides() *ConfigOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *TranslationRequest) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type Budget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PromptTemplate        string                   `protobuf:"bytes,1,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
-	Prompt                string                   `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	TranslationId         string                   `protobuf:"bytes,3,opt,name=translation_id,json=translationId,proto3" json:"translation_id,omitempty"`
-	InputLanguage         string                   `protobuf:"bytes,4,opt,name=input_language,json=inputLanguage,proto3" json:"input_language,omitempty"`
-	TargetLanguage        string                   `protobuf:"bytes,5,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
-	Level                 int32                    `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
-	Success               bool                     `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
-	InferenceOutput       string                   `protobuf:"bytes,8,opt,name=inference_output,json=inferenceOutput,proto3" json:"inference_output,omitempty"`
-	ExecutionOutput       string                   `protobuf:"bytes,9,opt,name=execution_output,json=executionOutput,proto3" json:"execution_output,omitempty"`
-	SourceCode            string                   `protobuf:"bytes,10,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
-	ExtractedSourceCode   string                   `protobuf:"bytes,11,opt,name=extracted_source_code,json=extractedSourceCode,proto3" json:"extracted_source_code,omitempty"`
-	ParentEdgeId          int32                    `protobuf:"varint,12,opt,name=parent_edge_id,json=parentEdgeId,proto3" json:"parent_edge_id,omitempty"`
+	MaxInferences      int32 `protobuf:"varint,1,opt,name=max_inferences,json=maxInferences,proto3" json:"max_inferences,omitempty"`
+	MaxGeneratedTokens int64 `protobuf:"varint,2,opt,name=max_generated_tokens,json=maxGeneratedTokens,proto3" json:"max_generated_tokens,omitempty"`
+	MaxExecutions      int32 `protobuf:"varint,3,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	MaxWallTimeMs      int64 `protobuf:"varint,4,opt,name=max_wall_time_ms,json=maxWallTimeMs,proto3" json:"max_wall_time_ms,omitempty"`
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Budget) GetMaxInferences() int32 {
+	if x != nil {
+		return x.MaxInferences
+	}
+	return 0
+}
+
+func (x *Budget) GetMaxGeneratedTokens() int64 {
+	if x != nil {
+		return x.MaxGeneratedTokens
+	}
+	return 0
+}
+
+func (x *Budget) GetMaxExecutions() int32 {
+	if x != nil {
+		return x.MaxExecutions
+	}
+	return 0
+}
+
+func (x *Budget) GetMaxWallTimeMs() int64 {
+	if x != nil {
+		return x.MaxWallTimeMs
+	}
+	return 0
+}
+
+type BoolOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BoolOverride) Reset() {
+	*x = BoolOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoolOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolOverride) ProtoMessage() {}
+
+func (x *BoolOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolOverride.ProtoReflect.Descriptor instead.
+func (*BoolOverride) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BoolOverride) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+type Int32Override struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Int32Override) Reset() {
+	*x = Int32Override{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Int32Override) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int32Override) ProtoMessage() {}
+
+func (x *Int32Override) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int32Override.ProtoReflect.Descriptor instead.
+func (*Int32Override) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Int32Override) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type FloatOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FloatOverride) Reset() {
+	*x = FloatOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatOverride) ProtoMessage() {}
+
+func (x *FloatOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatOverride.ProtoReflect.Descriptor instead.
+func (*FloatOverride) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FloatOverride) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type ConfigOverrides struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpansionDepth                 *Int32Override  `protobuf:"bytes,1,opt,name=expansion_depth,json=expansionDepth,proto3" json:"expansion_depth,omitempty"`
+	EarlyStop                      *BoolOverride   `protobuf:"bytes,2,opt,name=early_stop,json=earlyStop,proto3" json:"early_stop,omitempty"`
+	VerifyIntermediateTranslations *BoolOverride   `protobuf:"bytes,3,opt,name=verify_intermediate_translations,json=verifyIntermediateTranslations,proto3" json:"verify_intermediate_translations,omitempty"`
+	ComputeEfficientMode           *BoolOverride   `protobuf:"bytes,4,opt,name=compute_efficient_mode,json=computeEfficientMode,proto3" json:"compute_efficient_mode,omitempty"`
+	MaxGeneratedTokens             *Int32Override  `protobuf:"bytes,5,opt,name=max_generated_tokens,json=maxGeneratedTokens,proto3" json:"max_generated_tokens,omitempty"`
+	Temperature                    *FloatOverride  `protobuf:"bytes,6,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	TopP                           *FloatOverride  `protobuf:"bytes,7,opt,name=top_p,json=topP,proto3" json:"top_p,omitempty"`
+	TopK                           *Int32Override  `protobuf:"bytes,8,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	Seed                           *Int32Override  `protobuf:"bytes,9,opt,name=seed,proto3" json:"seed,omitempty"`
+	PanEtAlRepairRounds            *Int32Override  `protobuf:"bytes,10,opt,name=pan_et_al_repair_rounds,json=panEtAlRepairRounds,proto3" json:"pan_et_al_repair_rounds,omitempty"`
+	CandidatesPerEdge              *Int32Override  `protobuf:"bytes,11,opt,name=candidates_per_edge,json=candidatesPerEdge,proto3" json:"candidates_per_edge,omitempty"`
+	SearchStrategy                 *StringOverride `protobuf:"bytes,12,opt,name=search_strategy,json=searchStrategy,proto3" json:"search_strategy,omitempty"`
+	SearchScorer                   *StringOverride `protobuf:"bytes,13,opt,name=search_scorer,json=searchScorer,proto3" json:"search_scorer,omitempty"`
+	SearchWidth                    *Int32Override  `protobuf:"bytes,14,opt,name=search_width,json=searchWidth,proto3" json:"search_width,omitempty"`
+	PathOrdering                   *StringOverride `protobuf:"bytes,15,opt,name=path_ordering,json=pathOrdering,proto3" json:"path_ordering,omitempty"`
+	MaxRepairAttempts              *Int32Override  `protobuf:"bytes,16,opt,name=max_repair_attempts,json=maxRepairAttempts,proto3" json:"max_repair_attempts,omitempty"`
+	CodeExtraction                 *StringOverride `protobuf:"bytes,17,opt,name=code_extraction,json=codeExtraction,proto3" json:"code_extraction,omitempty"`
+	CheckExtractedSyntax           *BoolOverride   `protobuf:"bytes,18,opt,name=check_extracted_syntax,json=checkExtractedSyntax,proto3" json:"check_extracted_syntax,omitempty"`
+	SyntaxPreCheck                 *BoolOverride   `protobuf:"bytes,19,opt,name=syntax_pre_check,json=syntaxPreCheck,proto3" json:"syntax_pre_check,omitempty"`
+}
+
+func (x *ConfigOverrides) Reset() {
+	*x = ConfigOverrides{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigOverrides) ProtoMessage() {}
+
+func (x *ConfigOverrides) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigOverrides.ProtoReflect.Descriptor instead.
+func (*ConfigOverrides) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfigOverrides) GetExpansionDepth() *Int32Override {
+	if x != nil {
+		return x.ExpansionDepth
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetEarlyStop() *BoolOverride {
+	if x != nil {
+		return x.EarlyStop
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetVerifyIntermediateTranslations() *BoolOverride {
+	if x != nil {
+		return x.VerifyIntermediateTranslations
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetComputeEfficientMode() *BoolOverride {
+	if x != nil {
+		return x.ComputeEfficientMode
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetMaxGeneratedTokens() *Int32Override {
+	if x != nil {
+		return x.MaxGeneratedTokens
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetTemperature() *FloatOverride {
+	if x != nil {
+		return x.Temperature
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetTopP() *FloatOverride {
+	if x != nil {
+		return x.TopP
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetTopK() *Int32Override {
+	if x != nil {
+		return x.TopK
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetSeed() *Int32Override {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetPanEtAlRepairRounds() *Int32Override {
+	if x != nil {
+		return x.PanEtAlRepairRounds
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetCandidatesPerEdge() *Int32Override {
+	if x != nil {
+		return x.CandidatesPerEdge
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetSearchStrategy() *StringOverride {
+	if x != nil {
+		return x.SearchStrategy
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetSearchScorer() *StringOverride {
+	if x != nil {
+		return x.SearchScorer
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetSearchWidth() *Int32Override {
+	if x != nil {
+		return x.SearchWidth
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetPathOrdering() *StringOverride {
+	if x != nil {
+		return x.PathOrdering
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetMaxRepairAttempts() *Int32Override {
+	if x != nil {
+		return x.MaxRepairAttempts
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetCodeExtraction() *StringOverride {
+	if x != nil {
+		return x.CodeExtraction
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetCheckExtractedSyntax() *BoolOverride {
+	if x != nil {
+		return x.CheckExtractedSyntax
+	}
+	return nil
+}
+
+func (x *ConfigOverrides) GetSyntaxPreCheck() *BoolOverride {
+	if x != nil {
+		return x.SyntaxPreCheck
+	}
+	return nil
+}
+
+type StringOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StringOverride) Reset() {
+	*x = StringOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringOverride) ProtoMessage() {}
+
+func (x *StringOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringOverride.ProtoReflect.Descriptor instead.
+func (*StringOverride) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StringOverride) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ResponseTranslationEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromptTemplate        string                   `protobuf:"bytes,1,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	Prompt                string                   `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	TranslationId         string                   `protobuf:"bytes,3,opt,name=translation_id,json=translationId,proto3" json:"translation_id,omitempty"`
+	InputLanguage         string                   `protobuf:"bytes,4,opt,name=input_language,json=inputLanguage,proto3" json:"input_language,omitempty"`
+	TargetLanguage        string                   `protobuf:"bytes,5,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
+	Level                 int32                    `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	Success               bool                     `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	InferenceOutput       string                   `protobuf:"bytes,8,opt,name=inference_output,json=inferenceOutput,proto3" json:"inference_output,omitempty"`
+	ExecutionOutput       string                   `protobuf:"bytes,9,opt,name=execution_output,json=executionOutput,proto3" json:"execution_output,omitempty"`
+	SourceCode            string                   `protobuf:"bytes,10,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	ExtractedSourceCode   string                   `protobuf:"bytes,11,opt,name=extracted_source_code,json=extractedSourceCode,proto3" json:"extracted_source_code,omitempty"`
+	ParentEdgeId          int32                    `protobuf:"varint,12,opt,name=parent_edge_id,json=parentEdgeId,proto3" json:"parent_edge_id,omitempty"`
 	Status                string                   `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
 	FuzzyTests            []*ResponseFuzzyTestCase `protobuf:"bytes,14,rep,name=fuzzy_tests,json=fuzzyTests,proto3" json:"fuzzy_tests,omitempty"`
 	UnitTests             []*ResponseUnitTestCase  `protobuf:"bytes,15,rep,name=unit_tests,json=unitTests,proto3" json:"unit_tests,omitempty"`
@@ -611,25 +1374,697 @@ type ResponseTranslationEdge struct {
 	WallTimeTestExecution int64                    `protobuf:"varint,18,opt,name=wallTimeTestExecution,proto3" json:"wallTimeTestExecution,omitempty"`
 	UsedMemoization       bool                     `protobuf:"varint,19,opt,name=usedMemoization,proto3" json:"usedMemoization,omitempty"`
 	UsedInferenceCache    bool                     `protobuf:"varint,20,opt,name=usedInferenceCache,proto3" json:"usedInferenceCache,omitempty"`
+	FailedTestCategories  []string                 `protobuf:"bytes,21,rep,name=failed_test_categories,json=failedTestCategories,proto3" json:"failed_test_categories,omitempty"`
+	CandidateIndex        int32                    `protobuf:"varint,22,opt,name=candidate_index,json=candidateIndex,proto3" json:"candidate_index,omitempty"`
+	Usage                 *ResponseTokenUsage      `protobuf:"bytes,23,opt,name=usage,proto3" json:"usage,omitempty"`
+	LogProb               float64                  `protobuf:"fixed64,24,opt,name=log_prob,json=logProb,proto3" json:"log_prob,omitempty"`
+	RepairAttempt         int32                    `protobuf:"varint,25,opt,name=repair_attempt,json=repairAttempt,proto3" json:"repair_attempt,omitempty"`
+	ExtractionStrategy    string                   `protobuf:"bytes,26,opt,name=extraction_strategy,json=extractionStrategy,proto3" json:"extraction_strategy,omitempty"`
+}
+
+func (x *ResponseTranslationEdge) Reset() {
+	*x = ResponseTranslationEdge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseTranslationEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseTranslationEdge) ProtoMessage() {}
+
+func (x *ResponseTranslationEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseTranslationEdge.ProtoReflect.Descriptor instead.
+func (*ResponseTranslationEdge) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResponseTranslationEdge) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetTranslationId() string {
+	if x != nil {
+		return x.TranslationId
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetInputLanguage() string {
+	if x != nil {
+		return x.InputLanguage
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetTargetLanguage() string {
+	if x != nil {
+		return x.TargetLanguage
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResponseTranslationEdge) GetInferenceOutput() string {
+	if x != nil {
+		return x.InferenceOutput
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetExecutionOutput() string {
+	if x != nil {
+		return x.ExecutionOutput
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetSourceCode() string {
+	if x != nil {
+		return x.SourceCode
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetExtractedSourceCode() string {
+	if x != nil {
+		return x.ExtractedSourceCode
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetParentEdgeId() int32 {
+	if x != nil {
+		return x.ParentEdgeId
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ResponseTranslationEdge) GetFuzzyTests() []*ResponseFuzzyTestCase {
+	if x != nil {
+		return x.FuzzyTests
+	}
+	return nil
+}
+
+func (x *ResponseTranslationEdge) GetUnitTests() []*ResponseUnitTestCase {
+	if x != nil {
+		return x.UnitTests
+	}
+	return nil
+}
+
+func (x *ResponseTranslationEdge) GetEdgeId() int32 {
+	if x != nil {
+		return x.EdgeId
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetWallTimeInference() int64 {
+	if x != nil {
+		return x.WallTimeInference
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetWallTimeTestExecution() int64 {
+	if x != nil {
+		return x.WallTimeTestExecution
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetUsedMemoization() bool {
+	if x != nil {
+		return x.UsedMemoization
+	}
+	return false
+}
+
+func (x *ResponseTranslationEdge) GetUsedInferenceCache() bool {
+	if x != nil {
+		return x.UsedInferenceCache
+	}
+	return false
+}
+
+func (x *ResponseTranslationEdge) GetFailedTestCategories() []string {
+	if x != nil {
+		return x.FailedTestCategories
+	}
+	return nil
+}
+
+func (x *ResponseTranslationEdge) GetCandidateIndex() int32 {
+	if x != nil {
+		return x.CandidateIndex
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetUsage() *ResponseTokenUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *ResponseTranslationEdge) GetLogProb() float64 {
+	if x != nil {
+		return x.LogProb
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetRepairAttempt() int32 {
+	if x != nil {
+		return x.RepairAttempt
+	}
+	return 0
+}
+
+func (x *ResponseTranslationEdge) GetExtractionStrategy() string {
+	if x != nil {
+		return x.ExtractionStrategy
+	}
+	return ""
+}
+
+type ResponseTokenUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromptTokens     int64   `protobuf:"varint,1,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64   `protobuf:"varint,2,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	EstimatedCost    float64 `protobuf:"fixed64,3,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+}
+
+func (x *ResponseTokenUsage) Reset() {
+	*x = ResponseTokenUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseTokenUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseTokenUsage) ProtoMessage() {}
+
+func (x *ResponseTokenUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseTokenUsage.ProtoReflect.Descriptor instead.
+func (*ResponseTokenUsage) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResponseTokenUsage) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *ResponseTokenUsage) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *ResponseTokenUsage) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+type ResponseBudgetUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inferences      int32  `protobuf:"varint,1,opt,name=inferences,proto3" json:"inferences,omitempty"`
+	GeneratedTokens int64  `protobuf:"varint,2,opt,name=generated_tokens,json=generatedTokens,proto3" json:"generated_tokens,omitempty"`
+	Executions      int32  `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+	WallTimeMs      int64  `protobuf:"varint,4,opt,name=wall_time_ms,json=wallTimeMs,proto3" json:"wall_time_ms,omitempty"`
+	ExhaustedBudget string `protobuf:"bytes,5,opt,name=exhausted_budget,json=exhaustedBudget,proto3" json:"exhausted_budget,omitempty"`
+}
+
+func (x *ResponseBudgetUsage) Reset() {
+	*x = ResponseBudgetUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseBudgetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseBudgetUsage) ProtoMessage() {}
+
+func (x *ResponseBudgetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseBudgetUsage.ProtoReflect.Descriptor instead.
+func (*ResponseBudgetUsage) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResponseBudgetUsage) GetInferences() int32 {
+	if x != nil {
+		return x.Inferences
+	}
+	return 0
+}
+
+func (x *ResponseBudgetUsage) GetGeneratedTokens() int64 {
+	if x != nil {
+		return x.GeneratedTokens
+	}
+	return 0
+}
+
+func (x *ResponseBudgetUsage) GetExecutions() int32 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *ResponseBudgetUsage) GetWallTimeMs() int64 {
+	if x != nil {
+		return x.WallTimeMs
+	}
+	return 0
+}
+
+func (x *ResponseBudgetUsage) GetExhaustedBudget() string {
+	if x != nil {
+		return x.ExhaustedBudget
+	}
+	return ""
+}
+
+type ResponseTranslationPath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TranslationEdges  []*ResponseTranslationEdge `protobuf:"bytes,1,rep,name=translation_edges,json=translationEdges,proto3" json:"translation_edges,omitempty"`
+	EdgeIndexMemoized []bool                     `protobuf:"varint,2,rep,packed,name=edge_index_memoized,json=edgeIndexMemoized,proto3" json:"edge_index_memoized,omitempty"`
+}
+
+func (x *ResponseTranslationPath) Reset() {
+	*x = ResponseTranslationPath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseTranslationPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseTranslationPath) ProtoMessage() {}
+
+func (x *ResponseTranslationPath) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseTranslationPath.ProtoReflect.Descriptor instead.
+func (*ResponseTranslationPath) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResponseTranslationPath) GetTranslationEdges() []*ResponseTranslationEdge {
+	if x != nil {
+		return x.TranslationEdges
+	}
+	return nil
+}
+
+func (x *ResponseTranslationPath) GetEdgeIndexMemoized() []bool {
+	if x != nil {
+		return x.EdgeIndexMemoized
+	}
+	return nil
+}
+
+type TranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TranslationRequest *TranslationRequest        `protobuf:"bytes,1,opt,name=translation_request,json=translationRequest,proto3" json:"translation_request,omitempty"`
+	Paths              []*ResponseTranslationPath `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Error              string                     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Usage              *ResponseTokenUsage        `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	BudgetUsage        *ResponseBudgetUsage       `protobuf:"bytes,5,opt,name=budget_usage,json=budgetUsage,proto3" json:"budget_usage,omitempty"`
+}
+
+func (x *TranslationResponse) Reset() {
+	*x = TranslationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationResponse) ProtoMessage() {}
+
+func (x *TranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationResponse.ProtoReflect.Descriptor instead.
+func (*TranslationResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TranslationResponse) GetTranslationRequest() *TranslationRequest {
+	if x != nil {
+		return x.TranslationRequest
+	}
+	return nil
+}
+
+func (x *TranslationResponse) GetPaths() []*ResponseTranslationPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *TranslationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TranslationResponse) GetUsage() *ResponseTokenUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *TranslationResponse) GetBudgetUsage() *ResponseBudgetUsage {
+	if x != nil {
+		return x.BudgetUsage
+	}
+	return nil
+}
+
+type BatchTranslationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TranslationRequests []*TranslationRequest `protobuf:"bytes,1,rep,name=translation_requests,json=translationRequests,proto3" json:"translation_requests,omitempty"`
+	Id                  string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	FileBaseName        string                `protobuf:"bytes,3,opt,name=file_base_name,json=fileBaseName,proto3" json:"file_base_name,omitempty"`
+	FileSavePath        string                `protobuf:"bytes,4,opt,name=file_save_path,json=fileSavePath,proto3" json:"file_save_path,omitempty"`
+	Overrides           *ConfigOverrides      `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	Budget              *Budget               `protobuf:"bytes,6,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (x *BatchTranslationRequest) Reset() {
+	*x = BatchTranslationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTranslationRequest) ProtoMessage() {}
+
+func (x *BatchTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTranslationRequest.ProtoReflect.Descriptor instead.
+func (*BatchTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchTranslationRequest) GetTranslationRequests() []*TranslationRequest {
+	if x != nil {
+		return x.TranslationRequests
+	}
+	return nil
+}
+
+func (x *BatchTranslationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchTranslationRequest) GetFileBaseName() string {
+	if x != nil {
+		return x.FileBaseName
+	}
+	return ""
+}
+
+func (x *BatchTranslationRequest) GetFileSavePath() string {
+	if x != nil {
+		return x.FileSavePath
+	}
+	return ""
+}
+
+func (x *BatchTranslationRequest) GetOverrides() *ConfigOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *BatchTranslationRequest) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type BatchTranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TranslationResponses []*TranslationResponse `protobuf:"bytes,1,rep,name=translation_responses,json=translationResponses,proto3" json:"translation_responses,omitempty"`
+	RequestId            string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ReturnedToDisk       bool                   `protobuf:"varint,3,opt,name=returnedToDisk,proto3" json:"returnedToDisk,omitempty"`
+	Usage                *ResponseTokenUsage    `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	BudgetUsage          *ResponseBudgetUsage   `protobuf:"bytes,5,opt,name=budget_usage,json=budgetUsage,proto3" json:"budget_usage,omitempty"`
+}
+
+func (x *BatchTranslationResponse) Reset() {
+	*x = BatchTranslationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTranslationResponse) ProtoMessage() {}
+
+func (x *BatchTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTranslationResponse.ProtoReflect.Descriptor instead.
+func (*BatchTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchTranslationResponse) GetTranslationResponses() []*TranslationResponse {
+	if x != nil {
+		return x.TranslationResponses
+	}
+	return nil
+}
+
+func (x *BatchTranslationResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BatchTranslationResponse) GetReturnedToDisk() bool {
+	if x != nil {
+		return x.ReturnedToDisk
+	}
+	return false
+}
+
+func (x *BatchTranslationResponse) GetUsage() *ResponseTokenUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
 }
 
-func (x *ResponseTranslationEdge) Reset() {
-	*x = ResponseTranslationEdge{}
+func (x *BatchTranslationResponse) GetBudgetUsage() *ResponseBudgetUsage {
+	if x != nil {
+		return x.BudgetUsage
+	}
+	return nil
+}
+
+type TranslationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId           string                   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Edge                *ResponseTranslationEdge `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
+	TranslationResponse *TranslationResponse     `protobuf:"bytes,3,opt,name=translation_response,json=translationResponse,proto3" json:"translation_response,omitempty"`
+}
+
+func (x *TranslationEvent) Reset() {
+	*x = TranslationEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[7]
+		mi := &file_protos_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseTranslationEdge) String() string {
+func (x *TranslationEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseTranslationEdge) ProtoMessage() {}
+func (*TranslationEvent) ProtoMessage() {}
 
-func (x *ResponseTranslationEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[7]
+func (x *TranslationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,177 +2075,247 @@ func (x *ResponseTranslationEdge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseTranslationEdge.ProtoReflect.Descriptor instead.
-func (*ResponseTranslationEdge) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use TranslationEvent.ProtoReflect.Descriptor instead.
+func (*TranslationEvent) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ResponseTranslationEdge) GetPromptTemplate() string {
+func (x *TranslationEvent) GetRequestId() string {
 	if x != nil {
-		return x.PromptTemplate
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ResponseTranslationEdge) GetPrompt() string {
+func (x *TranslationEvent) GetEdge() *ResponseTranslationEdge {
 	if x != nil {
-		return x.Prompt
+		return x.Edge
 	}
-	return ""
+	return nil
 }
 
-func (x *ResponseTranslationEdge) GetTranslationId() string {
+func (x *TranslationEvent) GetTranslationResponse() *TranslationResponse {
 	if x != nil {
-		return x.TranslationId
+		return x.TranslationResponse
 	}
-	return ""
+	return nil
 }
 
-func (x *ResponseTranslationEdge) GetInputLanguage() string {
-	if x != nil {
-		return x.InputLanguage
-	}
-	return ""
+type LanguageStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelName      string `protobuf:"bytes,1,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	SeedLanguage   string `protobuf:"bytes,2,opt,name=seed_language,json=seedLanguage,proto3" json:"seed_language,omitempty"`
+	TargetLanguage string `protobuf:"bytes,3,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
 }
 
-func (x *ResponseTranslationEdge) GetTargetLanguage() string {
-	if x != nil {
-		return x.TargetLanguage
+func (x *LanguageStatisticsRequest) Reset() {
+	*x = LanguageStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ResponseTranslationEdge) GetLevel() int32 {
-	if x != nil {
-		return x.Level
+func (x *LanguageStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageStatisticsRequest) ProtoMessage() {}
+
+func (x *LanguageStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ResponseTranslationEdge) GetSuccess() bool {
+// Deprecated: Use LanguageStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*LanguageStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LanguageStatisticsRequest) GetModelName() string {
 	if x != nil {
-		return x.Success
+		return x.ModelName
 	}
-	return false
+	return ""
 }
 
-func (x *ResponseTranslationEdge) GetInferenceOutput() string {
+func (x *LanguageStatisticsRequest) GetSeedLanguage() string {
 	if x != nil {
-		return x.InferenceOutput
+		return x.SeedLanguage
 	}
 	return ""
 }
 
-func (x *ResponseTranslationEdge) GetExecutionOutput() string {
+func (x *LanguageStatisticsRequest) GetTargetLanguage() string {
 	if x != nil {
-		return x.ExecutionOutput
+		return x.TargetLanguage
 	}
 	return ""
 }
 
-func (x *ResponseTranslationEdge) GetSourceCode() string {
+type LanguagePathStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelName                  string   `protobuf:"bytes,1,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	TargetLanguage             string   `protobuf:"bytes,2,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
+	Languages                  []string `protobuf:"bytes,3,rep,name=languages,proto3" json:"languages,omitempty"`
+	Attempts                   int64    `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Successes                  int64    `protobuf:"varint,5,opt,name=successes,proto3" json:"successes,omitempty"`
+	InferenceFailures          int64    `protobuf:"varint,6,opt,name=inference_failures,json=inferenceFailures,proto3" json:"inference_failures,omitempty"`
+	ExtractionFailures         int64    `protobuf:"varint,7,opt,name=extraction_failures,json=extractionFailures,proto3" json:"extraction_failures,omitempty"`
+	CompilationRuntimeFailures int64    `protobuf:"varint,8,opt,name=compilation_runtime_failures,json=compilationRuntimeFailures,proto3" json:"compilation_runtime_failures,omitempty"`
+	TestFailures               int64    `protobuf:"varint,9,opt,name=test_failures,json=testFailures,proto3" json:"test_failures,omitempty"`
+	TimeoutFailures            int64    `protobuf:"varint,10,opt,name=timeout_failures,json=timeoutFailures,proto3" json:"timeout_failures,omitempty"`
+	SuccessRate                float32  `protobuf:"fixed32,11,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+}
+
+func (x *LanguagePathStatistics) Reset() {
+	*x = LanguagePathStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LanguagePathStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguagePathStatistics) ProtoMessage() {}
+
+func (x *LanguagePathStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguagePathStatistics.ProtoReflect.Descriptor instead.
+func (*LanguagePathStatistics) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LanguagePathStatistics) GetModelName() string {
 	if x != nil {
-		return x.SourceCode
+		return x.ModelName
 	}
 	return ""
 }
 
-func (x *ResponseTranslationEdge) GetExtractedSourceCode() string {
+func (x *LanguagePathStatistics) GetTargetLanguage() string {
 	if x != nil {
-		return x.ExtractedSourceCode
+		return x.TargetLanguage
 	}
 	return ""
 }
 
-func (x *ResponseTranslationEdge) GetParentEdgeId() int32 {
+func (x *LanguagePathStatistics) GetLanguages() []string {
 	if x != nil {
-		return x.ParentEdgeId
+		return x.Languages
 	}
-	return 0
+	return nil
 }
 
-func (x *ResponseTranslationEdge) GetStatus() string {
+func (x *LanguagePathStatistics) GetAttempts() int64 {
 	if x != nil {
-		return x.Status
+		return x.Attempts
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseTranslationEdge) GetFuzzyTests() []*ResponseFuzzyTestCase {
+func (x *LanguagePathStatistics) GetSuccesses() int64 {
 	if x != nil {
-		return x.FuzzyTests
+		return x.Successes
 	}
-	return nil
+	return 0
 }
 
-func (x *ResponseTranslationEdge) GetUnitTests() []*ResponseUnitTestCase {
+func (x *LanguagePathStatistics) GetInferenceFailures() int64 {
 	if x != nil {
-		return x.UnitTests
+		return x.InferenceFailures
 	}
-	return nil
+	return 0
 }
 
-func (x *ResponseTranslationEdge) GetEdgeId() int32 {
+func (x *LanguagePathStatistics) GetExtractionFailures() int64 {
 	if x != nil {
-		return x.EdgeId
+		return x.ExtractionFailures
 	}
 	return 0
 }
 
-func (x *ResponseTranslationEdge) GetWallTimeInference() int64 {
+func (x *LanguagePathStatistics) GetCompilationRuntimeFailures() int64 {
 	if x != nil {
-		return x.WallTimeInference
+		return x.CompilationRuntimeFailures
 	}
 	return 0
 }
 
-func (x *ResponseTranslationEdge) GetWallTimeTestExecution() int64 {
+func (x *LanguagePathStatistics) GetTestFailures() int64 {
 	if x != nil {
-		return x.WallTimeTestExecution
+		return x.TestFailures
 	}
 	return 0
 }
 
-func (x *ResponseTranslationEdge) GetUsedMemoization() bool {
+func (x *LanguagePathStatistics) GetTimeoutFailures() int64 {
 	if x != nil {
-		return x.UsedMemoization
+		return x.TimeoutFailures
 	}
-	return false
+	return 0
 }
 
-func (x *ResponseTranslationEdge) GetUsedInferenceCache() bool {
+func (x *LanguagePathStatistics) GetSuccessRate() float32 {
 	if x != nil {
-		return x.UsedInferenceCache
+		return x.SuccessRate
 	}
-	return false
+	return 0
 }
 
-type ResponseTranslationPath struct {
+type LanguageStatisticsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TranslationEdges  []*ResponseTranslationEdge `protobuf:"bytes,1,rep,name=translation_edges,json=translationEdges,proto3" json:"translation_edges,omitempty"`
-	EdgeIndexMemoized []bool                     `protobuf:"varint,2,rep,packed,name=edge_index_memoized,json=edgeIndexMemoized,proto3" json:"edge_index_memoized,omitempty"`
+	Statistics []*LanguagePathStatistics `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
 }
 
-func (x *ResponseTranslationPath) Reset() {
-	*x = ResponseTranslationPath{}
+func (x *LanguageStatisticsResponse) Reset() {
+	*x = LanguageStatisticsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[8]
+		mi := &file_protos_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseTranslationPath) String() string {
+func (x *LanguageStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseTranslationPath) ProtoMessage() {}
+func (*LanguageStatisticsResponse) ProtoMessage() {}
 
-func (x *ResponseTranslationPath) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[8]
+func (x *LanguageStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,51 +2326,43 @@ func (x *ResponseTranslationPath) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseTranslationPath.ProtoReflect.Descriptor instead.
-func (*ResponseTranslationPath) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ResponseTranslationPath) GetTranslationEdges() []*ResponseTranslationEdge {
-	if x != nil {
-		return x.TranslationEdges
-	}
-	return nil
+// Deprecated: Use LanguageStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*LanguageStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ResponseTranslationPath) GetEdgeIndexMemoized() []bool {
+func (x *LanguageStatisticsResponse) GetStatistics() []*LanguagePathStatistics {
 	if x != nil {
-		return x.EdgeIndexMemoized
+		return x.Statistics
 	}
 	return nil
 }
 
-type TranslationResponse struct {
+type JobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TranslationRequest *TranslationRequest        `protobuf:"bytes,1,opt,name=translation_request,json=translationRequest,proto3" json:"translation_request,omitempty"`
-	Paths              []*ResponseTranslationPath `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *TranslationResponse) Reset() {
-	*x = TranslationResponse{}
+func (x *JobRequest) Reset() {
+	*x = JobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[9]
+		mi := &file_protos_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TranslationResponse) String() string {
+func (x *JobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranslationResponse) ProtoMessage() {}
+func (*JobRequest) ProtoMessage() {}
 
-func (x *TranslationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[9]
+func (x *JobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,53 +2373,49 @@ func (x *TranslationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranslationResponse.ProtoReflect.Descriptor instead.
-func (*TranslationResponse) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *TranslationResponse) GetTranslationRequest() *TranslationRequest {
-	if x != nil {
-		return x.TranslationRequest
-	}
-	return nil
+// Deprecated: Use JobRequest.ProtoReflect.Descriptor instead.
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TranslationResponse) GetPaths() []*ResponseTranslationPath {
+func (x *JobRequest) GetJobId() string {
 	if x != nil {
-		return x.Paths
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type BatchTranslationRequest struct {
+type JobStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TranslationRequests []*TranslationRequest `protobuf:"bytes,1,rep,name=translation_requests,json=translationRequests,proto3" json:"translation_requests,omitempty"`
-	Id                  string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	FileBaseName        string                `protobuf:"bytes,3,opt,name=file_base_name,json=fileBaseName,proto3" json:"file_base_name,omitempty"`
-	FileSavePath        string                `protobuf:"bytes,4,opt,name=file_save_path,json=fileSavePath,proto3" json:"file_save_path,omitempty"`
+	JobId             string         `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status            ResponseStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ResponseStatus" json:"status,omitempty"`
+	TotalRequests     int32          `protobuf:"varint,3,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
+	CompletedRequests int32          `protobuf:"varint,4,opt,name=completed_requests,json=completedRequests,proto3" json:"completed_requests,omitempty"`
+	Error             string         `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	SubmittedAt       int64          `protobuf:"varint,6,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	FinishedAt        int64          `protobuf:"varint,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
 }
 
-func (x *BatchTranslationRequest) Reset() {
-	*x = BatchTranslationRequest{}
+func (x *JobStatus) Reset() {
+	*x = JobStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[10]
+		mi := &file_protos_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchTranslationRequest) String() string {
+func (x *JobStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchTranslationRequest) ProtoMessage() {}
+func (*JobStatus) ProtoMessage() {}
 
-func (x *BatchTranslationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[10]
+func (x *JobStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,100 +2426,58 @@ func (x *BatchTranslationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchTranslationRequest.ProtoReflect.Descriptor instead.
-func (*BatchTranslationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *BatchTranslationRequest) GetTranslationRequests() []*TranslationRequest {
-	if x != nil {
-		return x.TranslationRequests
-	}
-	return nil
-}
-
-func (x *BatchTranslationRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *BatchTranslationRequest) GetFileBaseName() string {
-	if x != nil {
-		return x.FileBaseName
-	}
-	return ""
+// Deprecated: Use JobStatus.ProtoReflect.Descriptor instead.
+func (*JobStatus) Descriptor() ([]byte, []int) {
+	return file_protos_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *BatchTranslationRequest) GetFileSavePath() string {
+func (x *JobStatus) GetJobId() string {
 	if x != nil {
-		return x.FileSavePath
+		return x.JobId
 	}
 	return ""
 }
 
-type BatchTranslationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TranslationResponses []*TranslationResponse `protobuf:"bytes,1,rep,name=translation_responses,json=translationResponses,proto3" json:"translation_responses,omitempty"`
-	RequestId            string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ReturnedToDisk       bool                   `protobuf:"varint,3,opt,name=returnedToDisk,proto3" json:"returnedToDisk,omitempty"`
-}
-
-func (x *BatchTranslationResponse) Reset() {
-	*x = BatchTranslationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *BatchTranslationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BatchTranslationResponse) ProtoMessage() {}
-
-func (x *BatchTranslationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JobStatus) GetStatus() ResponseStatus {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ResponseStatus_PENDING
 }
 
-// Deprecated: Use BatchTranslationResponse.ProtoReflect.Descriptor instead.
-func (*BatchTranslationResponse) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{11}
+func (x *JobStatus) GetTotalRequests() int32 {
+	if x != nil {
+		return x.TotalRequests
+	}
+	return 0
 }
 
-func (x *BatchTranslationResponse) GetTranslationResponses() []*TranslationResponse {
+func (x *JobStatus) GetCompletedRequests() int32 {
 	if x != nil {
-		return x.TranslationResponses
+		return x.CompletedRequests
 	}
-	return nil
+	return 0
 }
 
-func (x *BatchTranslationResponse) GetRequestId() string {
+func (x *JobStatus) GetError() string {
 	if x != nil {
-		return x.RequestId
+		return x.Error
 	}
 	return ""
 }
 
-func (x *BatchTranslationResponse) GetReturnedToDisk() bool {
+func (x *JobStatus) GetSubmittedAt() int64 {
 	if x != nil {
-		return x.ReturnedToDisk
+		return x.SubmittedAt
 	}
-	return false
+	return 0
+}
+
+func (x *JobStatus) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
 }
 
 type StartEndpointRequest struct {
@@ -1045,7 +2496,7 @@ type StartEndpointRequest struct {
 func (x *StartEndpointRequest) Reset() {
 	*x = StartEndpointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[12]
+		mi := &file_protos_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1058,7 +2509,7 @@ func (x *StartEndpointRequest) String() string {
 func (*StartEndpointRequest) ProtoMessage() {}
 
 func (x *StartEndpointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[12]
+	mi := &file_protos_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,7 +2522,7 @@ func (x *StartEndpointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartEndpointRequest.ProtoReflect.Descriptor instead.
 func (*StartEndpointRequest) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{12}
+	return file_protos_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *StartEndpointRequest) GetModelName() string {
@@ -1127,7 +2578,7 @@ type StopEndpointRequest struct {
 func (x *StopEndpointRequest) Reset() {
 	*x = StopEndpointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[13]
+		mi := &file_protos_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1140,7 +2591,7 @@ func (x *StopEndpointRequest) String() string {
 func (*StopEndpointRequest) ProtoMessage() {}
 
 func (x *StopEndpointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[13]
+	mi := &file_protos_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,7 +2604,7 @@ func (x *StopEndpointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopEndpointRequest.ProtoReflect.Descriptor instead.
 func (*StopEndpointRequest) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{13}
+	return file_protos_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *StopEndpointRequest) GetLaunchId() int64 {
@@ -1174,7 +2625,7 @@ type LaunchResponse struct {
 func (x *LaunchResponse) Reset() {
 	*x = LaunchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[14]
+		mi := &file_protos_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1187,7 +2638,7 @@ func (x *LaunchResponse) String() string {
 func (*LaunchResponse) ProtoMessage() {}
 
 func (x *LaunchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[14]
+	mi := &file_protos_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,7 +2651,7 @@ func (x *LaunchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LaunchResponse.ProtoReflect.Descriptor instead.
 func (*LaunchResponse) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{14}
+	return file_protos_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *LaunchResponse) GetLaunchId() int64 {
@@ -1225,7 +2676,7 @@ type VerificationRequest struct {
 func (x *VerificationRequest) Reset() {
 	*x = VerificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[15]
+		mi := &file_protos_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +2689,7 @@ func (x *VerificationRequest) String() string {
 func (*VerificationRequest) ProtoMessage() {}
 
 func (x *VerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[15]
+	mi := &file_protos_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +2702,7 @@ func (x *VerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationRequest.ProtoReflect.Descriptor instead.
 func (*VerificationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{15}
+	return file_protos_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *VerificationRequest) GetId() string {
@@ -1294,16 +2745,18 @@ type VerificationResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VerificationRequest *VerificationRequest     `protobuf:"bytes,1,opt,name=verification_request,json=verificationRequest,proto3" json:"verification_request,omitempty"`
-	FuzzyTests          []*ResponseFuzzyTestCase `protobuf:"bytes,2,rep,name=fuzzy_tests,json=fuzzyTests,proto3" json:"fuzzy_tests,omitempty"`
-	UnitTests           []*ResponseUnitTestCase  `protobuf:"bytes,3,rep,name=unit_tests,json=unitTests,proto3" json:"unit_tests,omitempty"`
-	Status              string                   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	VerificationRequest  *VerificationRequest     `protobuf:"bytes,1,opt,name=verification_request,json=verificationRequest,proto3" json:"verification_request,omitempty"`
+	FuzzyTests           []*ResponseFuzzyTestCase `protobuf:"bytes,2,rep,name=fuzzy_tests,json=fuzzyTests,proto3" json:"fuzzy_tests,omitempty"`
+	UnitTests            []*ResponseUnitTestCase  `protobuf:"bytes,3,rep,name=unit_tests,json=unitTests,proto3" json:"unit_tests,omitempty"`
+	Status               string                   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	FailedTestCategories []string                 `protobuf:"bytes,7,rep,name=failed_test_categories,json=failedTestCategories,proto3" json:"failed_test_categories,omitempty"`
+	Error                string                   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (x *VerificationResponse) Reset() {
 	*x = VerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[16]
+		mi := &file_protos_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1316,7 +2769,7 @@ func (x *VerificationResponse) String() string {
 func (*VerificationResponse) ProtoMessage() {}
 
 func (x *VerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[16]
+	mi := &file_protos_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,7 +2782,7 @@ func (x *VerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationResponse.ProtoReflect.Descriptor instead.
 func (*VerificationResponse) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{16}
+	return file_protos_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *VerificationResponse) GetVerificationRequest() *VerificationRequest {
@@ -1360,6 +2813,20 @@ func (x *VerificationResponse) GetStatus() string {
 	return ""
 }
 
+func (x *VerificationResponse) GetFailedTestCategories() []string {
+	if x != nil {
+		return x.FailedTestCategories
+	}
+	return nil
+}
+
+func (x *VerificationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type BatchVerificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1372,7 +2839,7 @@ type BatchVerificationRequest struct {
 func (x *BatchVerificationRequest) Reset() {
 	*x = BatchVerificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[17]
+		mi := &file_protos_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1385,7 +2852,7 @@ func (x *BatchVerificationRequest) String() string {
 func (*BatchVerificationRequest) ProtoMessage() {}
 
 func (x *BatchVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[17]
+	mi := &file_protos_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,7 +2865,7 @@ func (x *BatchVerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchVerificationRequest.ProtoReflect.Descriptor instead.
 func (*BatchVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{17}
+	return file_protos_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *BatchVerificationRequest) GetVerificationRequests() []*VerificationRequest {
@@ -1427,7 +2894,7 @@ type BatchVerificationResponse struct {
 func (x *BatchVerificationResponse) Reset() {
 	*x = BatchVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_proto_msgTypes[18]
+		mi := &file_protos_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1440,7 +2907,7 @@ func (x *BatchVerificationResponse) String() string {
 func (*BatchVerificationResponse) ProtoMessage() {}
 
 func (x *BatchVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_msgTypes[18]
+	mi := &file_protos_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,7 +2920,7 @@ func (x *BatchVerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchVerificationResponse.ProtoReflect.Descriptor instead.
 func (*BatchVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_protos_proto_rawDescGZIP(), []int{18}
+	return file_protos_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *BatchVerificationResponse) GetVerificationRequests() *VerificationRequest {
@@ -1473,279 +2940,596 @@ func (x *BatchVerificationResponse) GetVerificationResponses() []*VerificationRe
 var File_protos_proto protoreflect.FileDescriptor
 
 var file_protos_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x73,
-	0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x66,
-	0x75, 0x7a, 0x7a, 0x79, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
-	0x52, 0x0a, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x0f,
-	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x0d, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x5f, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x69, 0x6e,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xc3,
-	0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x7a, 0x7a, 0x79,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x69,
-	0x6e, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x74, 0x64, 0x69, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61,
-	0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64,
-	0x43, 0x6f, 0x64, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0x61, 0x0a, 0x0c, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0xcd, 0x03, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x65, 0x64, 0x5f,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x65, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69,
-	0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x65, 0x78, 0x5f, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x67, 0x65, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x70, 0x72,
-	0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0xb0, 0x06, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1,
+	0x01, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0b,
+	0x66, 0x75, 0x7a, 0x7a, 0x79, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x0a, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x35, 0x0a,
+	0x0f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53,
+	0x75, 0x69, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x0f, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61,
+	0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x46, 0x75,
+	0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x64, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xd6, 0x03, 0x0a, 0x15, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24,
+	0x0a, 0x0e, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x7a, 0x65, 0x72, 0x6f,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x5a, 0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x06,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x70, 0x65, 0x61, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x8c, 0x03, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x5a,
+	0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x06, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x70, 0x65, 0x61, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x89, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x61, 0x0a, 0x0c,
+	0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22,
+	0x4b, 0x0a, 0x0f, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9e, 0x04, 0x0a,
+	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x65, 0x64,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29,
+	0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x09,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x65, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x09,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x06,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0xb1, 0x01,
+	0x0a, 0x06, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f,
+	0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x22, 0x24, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x25,
+	0x0a, 0x0d, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xcf, 0x08, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x2c, 0x0a, 0x0a, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x6f, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x70,
+	0x12, 0x57, 0x0a, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x1e, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x16, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x40,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x12, 0x6d, 0x61,
+	0x78, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x30, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x50, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x12, 0x22, 0x0a, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x12, 0x44, 0x0a, 0x17, 0x70, 0x61, 0x6e, 0x5f, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x13, 0x70, 0x61, 0x6e, 0x45, 0x74, 0x41, 0x6c, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x45, 0x64, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x34, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0b, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x0d, 0x70, 0x61, 0x74,
+	0x68, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x3e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x38, 0x0a, 0x0f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x16, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x79, 0x6e,
+	0x74, 0x61, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x14, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x12, 0x37,
+	0x0a, 0x10, 0x73, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0e, 0x73, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x50,
+	0x72, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xad, 0x08, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x64,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x0b,
+	0x66, 0x75, 0x7a, 0x7a, 0x79, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x7a, 0x7a,
+	0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x0a, 0x66, 0x75, 0x7a, 0x7a, 0x79,
+	0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x65,
+	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x64,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x64,
+	0x4d, 0x65, 0x6d, 0x6f, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x29, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x6c, 0x6f, 0x67, 0x50, 0x72, 0x6f, 0x62, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x2f,
+	0x0a, 0x13, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22,
+	0x8d, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x22,
+	0xcd, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22,
+	0x90, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x11, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x67, 0x65,
+	0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x67,
+	0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52,
+	0x11, 0x65, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x69, 0x7a,
+	0x65, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x13, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x12, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x37, 0x0a, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x17, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x61, 0x76,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x61, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x90, 0x02, 0x0a, 0x18,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x14, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x54, 0x6f,
+	0x44, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa8,
+	0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65,
+	0x12, 0x47, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x75, 0x61, 0x67, 0x65, 0x22, 0xcd, 0x03, 0x0a, 0x16, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x40, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x61, 0x74, 0x65, 0x22, 0x55, 0x0a, 0x1a, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x23, 0x0a, 0x0a, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x22, 0xfb, 0x01, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15,
+	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0xae,
+	0x01, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x70, 0x75, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x70, 0x75, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x72, 0x61, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x72, 0x61, 0x50, 0x61, 0x74, 0x68, 0x22,
+	0x32, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x75, 0x6e, 0x63,
+	0x68, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x0e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68,
+	0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
 	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x29, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45,
-	0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a,
-	0x0b, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x7a,
-	0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x0a, 0x66, 0x75, 0x7a, 0x7a,
-	0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x74,
-	0x65, 0x73, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07,
-	0x65, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65,
-	0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d,
-	0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x11, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x15, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x65, 0x73, 0x74,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65,
-	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x12, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x45, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
-	0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x64, 0x67, 0x65, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x08, 0x52, 0x11, 0x65, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x65,
-	0x6d, 0x6f, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
-	0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x46, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24,
-	0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x61, 0x76, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x22, 0xac, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x44, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x44,
-	0x69, 0x73, 0x6b, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x67,
-	0x70, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x70, 0x75,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70,
-	0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x72, 0x61, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x72, 0x61,
-	0x50, 0x61, 0x74, 0x68, 0x22, 0x32, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
-	0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x0e, 0x4c, 0x61, 0x75, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
-	0x75, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
-	0x61, 0x75, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x29, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52,
-	0x09, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
-	0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x56, 0x65,
+	0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22,
+	0xb2, 0x02, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x14, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x13, 0x76, 0x65,
 	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x5f,
-	0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x73, 0x65, 0x52, 0x0a, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12,
-	0x34, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x6e,
-	0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74,
-	0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x75, 0x0a,
-	0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x15, 0x76, 0x65, 0x72,
+	0x74, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x0a,
+	0x66, 0x75, 0x7a, 0x7a, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x75, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x49, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x19,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x15, 0x76, 0x65, 0x72,
 	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x14,
 	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x49, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4c, 0x0a,
-	0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x2a, 0x94, 0x01, 0x0a, 0x0e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b,
-	0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50,
-	0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10,
-	0x03, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4b, 0x49, 0x50,
-	0x50, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
-	0x10, 0x06, 0x32, 0xc2, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x42, 0x61,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x15, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x2a, 0xa3, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12,
+	0x19, 0x0a, 0x15, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x45, 0x4e,
+	0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4b,
+	0x49, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x32, 0xdb, 0x03, 0x0a, 0x12, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x45, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x42, 0x61,
 	0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x65, 0x43, 0x41, 0x4b, 0x12, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x50, 0x61, 0x6e, 0x45, 0x74, 0x41, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
-	0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x75, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x19, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9a, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x66, 0x72,
-	0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x41, 0x0a, 0x17, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0b, 0x5a, 0x09, 0x2e, 0x2e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x48, 0x0a,
+	0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x43,
+	0x41, 0x4b, 0x12, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x61, 0x6e, 0x45, 0x74, 0x41, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75,
+	0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x2e,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc8, 0x01, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x2e, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x0b, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x0b, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x32, 0x9a, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x17, 0x4c,
+	0x61, 0x75, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0b,
+	0x5a, 0x09, 0x2e, 0x2e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1761,65 +3545,129 @@ func file_protos_proto_rawDescGZIP() []byte {
 }
 
 var file_protos_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protos_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_protos_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_protos_proto_goTypes = []interface{}{
-	(ResponseStatus)(0),               // 0: ResponseStatus
-	(*TestSuite)(nil),                 // 1: TestSuite
-	(*FuzzyTestCase)(nil),             // 2: FuzzyTestCase
-	(*ResponseFuzzyTestCase)(nil),     // 3: ResponseFuzzyTestCase
-	(*ResponseUnitTestCase)(nil),      // 4: ResponseUnitTestCase
-	(*UnitTestCase)(nil),              // 5: UnitTestCase
-	(*TargetSignature)(nil),           // 6: TargetSignature
-	(*TranslationRequest)(nil),        // 7: TranslationRequest
-	(*ResponseTranslationEdge)(nil),   // 8: ResponseTranslationEdge
-	(*ResponseTranslationPath)(nil),   // 9: ResponseTranslationPath
-	(*TranslationResponse)(nil),       // 10: TranslationResponse
-	(*BatchTranslationRequest)(nil),   // 11: BatchTranslationRequest
-	(*BatchTranslationResponse)(nil),  // 12: BatchTranslationResponse
-	(*StartEndpointRequest)(nil),      // 13: StartEndpointRequest
-	(*StopEndpointRequest)(nil),       // 14: StopEndpointRequest
-	(*LaunchResponse)(nil),            // 15: LaunchResponse
-	(*VerificationRequest)(nil),       // 16: VerificationRequest
-	(*VerificationResponse)(nil),      // 17: VerificationResponse
-	(*BatchVerificationRequest)(nil),  // 18: BatchVerificationRequest
-	(*BatchVerificationResponse)(nil), // 19: BatchVerificationResponse
+	(ResponseStatus)(0),                // 0: ResponseStatus
+	(*TestSuite)(nil),                  // 1: TestSuite
+	(*OutputComparator)(nil),           // 2: OutputComparator
+	(*FuzzyTestCase)(nil),              // 3: FuzzyTestCase
+	(*ResponseFuzzyTestCase)(nil),      // 4: ResponseFuzzyTestCase
+	(*ResponseUnitTestCase)(nil),       // 5: ResponseUnitTestCase
+	(*ResponseExecutionPhases)(nil),    // 6: ResponseExecutionPhases
+	(*UnitTestCase)(nil),               // 7: UnitTestCase
+	(*TargetSignature)(nil),            // 8: TargetSignature
+	(*TranslationRequest)(nil),         // 9: TranslationRequest
+	(*Budget)(nil),                     // 10: Budget
+	(*BoolOverride)(nil),               // 11: BoolOverride
+	(*Int32Override)(nil),              // 12: Int32Override
+	(*FloatOverride)(nil),              // 13: FloatOverride
+	(*ConfigOverrides)(nil),            // 14: ConfigOverrides
+	(*StringOverride)(nil),             // 15: StringOverride
+	(*ResponseTranslationEdge)(nil),    // 16: ResponseTranslationEdge
+	(*ResponseTokenUsage)(nil),         // 17: ResponseTokenUsage
+	(*ResponseBudgetUsage)(nil),        // 18: ResponseBudgetUsage
+	(*ResponseTranslationPath)(nil),    // 19: ResponseTranslationPath
+	(*TranslationResponse)(nil),        // 20: TranslationResponse
+	(*BatchTranslationRequest)(nil),    // 21: BatchTranslationRequest
+	(*BatchTranslationResponse)(nil),   // 22: BatchTranslationResponse
+	(*TranslationEvent)(nil),           // 23: TranslationEvent
+	(*LanguageStatisticsRequest)(nil),  // 24: LanguageStatisticsRequest
+	(*LanguagePathStatistics)(nil),     // 25: LanguagePathStatistics
+	(*LanguageStatisticsResponse)(nil), // 26: LanguageStatisticsResponse
+	(*JobRequest)(nil),                 // 27: JobRequest
+	(*JobStatus)(nil),                  // 28: JobStatus
+	(*StartEndpointRequest)(nil),       // 29: StartEndpointRequest
+	(*StopEndpointRequest)(nil),        // 30: StopEndpointRequest
+	(*LaunchResponse)(nil),             // 31: LaunchResponse
+	(*VerificationRequest)(nil),        // 32: VerificationRequest
+	(*VerificationResponse)(nil),       // 33: VerificationResponse
+	(*BatchVerificationRequest)(nil),   // 34: BatchVerificationRequest
+	(*BatchVerificationResponse)(nil),  // 35: BatchVerificationResponse
 }
 var file_protos_proto_depIdxs = []int32{
-	2,  // 0: TestSuite.fuzzy_suite:type_name -> FuzzyTestCase
-	5,  // 1: TestSuite.unit_test_suite:type_name -> UnitTestCase
-	1,  // 2: TranslationRequest.test_suite:type_name -> TestSuite
-	6,  // 3: TranslationRequest.target_signatures:type_name -> TargetSignature
-	3,  // 4: ResponseTranslationEdge.fuzzy_tests:type_name -> ResponseFuzzyTestCase
-	4,  // 5: ResponseTranslationEdge.unit_tests:type_name -> ResponseUnitTestCase
-	8,  // 6: ResponseTranslationPath.translation_edges:type_name -> ResponseTranslationEdge
-	7,  // 7: TranslationResponse.translation_request:type_name -> TranslationRequest
-	9,  // 8: TranslationResponse.paths:type_name -> ResponseTranslationPath
-	7,  // 9: BatchTranslationRequest.translation_requests:type_name -> TranslationRequest
-	10, // 10: BatchTranslationResponse.translation_responses:type_name -> TranslationResponse
-	1,  // 11: VerificationRequest.test_suite:type_name -> TestSuite
-	16, // 12: VerificationResponse.verification_request:type_name -> VerificationRequest
-	3,  // 13: VerificationResponse.fuzzy_tests:type_name -> ResponseFuzzyTestCase
-	4,  // 14: VerificationResponse.unit_tests:type_name -> ResponseUnitTestCase
-	16, // 15: BatchVerificationRequest.verification_requests:type_name -> VerificationRequest
-	16, // 16: BatchVerificationResponse.verification_requests:type_name -> VerificationRequest
-	17, // 17: BatchVerificationResponse.verification_responses:type_name -> VerificationResponse
-	11, // 18: TranslationService.BatchTranslate:input_type -> BatchTranslationRequest
-	11, // 19: TranslationService.BatchTranslateCAK:input_type -> BatchTranslationRequest
-	11, // 20: TranslationService.BatchPanEtAlTranslate:input_type -> BatchTranslationRequest
-	18, // 21: TranslationService.BatchRunVerification:input_type -> BatchVerificationRequest
-	13, // 22: InfrastructureService.LaunchInferenceEndpoint:input_type -> StartEndpointRequest
-	14, // 23: InfrastructureService.StopInferenceEndpoint:input_type -> StopEndpointRequest
-	12, // 24: TranslationService.BatchTranslate:output_type -> BatchTranslationResponse
-	12, // 25: TranslationService.BatchTranslateCAK:output_type -> BatchTranslationResponse
-	12, // 26: TranslationService.BatchPanEtAlTranslate:output_type -> BatchTranslationResponse
-	19, // 27: TranslationService.BatchRunVerification:output_type -> BatchVerificationResponse
-	15, // 28: InfrastructureService.LaunchInferenceEndpoint:output_type -> LaunchResponse
-	15, // 29: InfrastructureService.StopInferenceEndpoint:output_type -> LaunchResponse
-	24, // [24:30] is the sub-list for method output_type
-	18, // [18:24] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	3,  // 0: TestSuite.fuzzy_suite:type_name -> FuzzyTestCase
+	7,  // 1: TestSuite.unit_test_suite:type_name -> UnitTestCase
+	2,  // 2: TestSuite.fuzzy_comparator:type_name -> OutputComparator
+	2,  // 3: FuzzyTestCase.comparator:type_name -> OutputComparator
+	6,  // 4: ResponseFuzzyTestCase.phases:type_name -> ResponseExecutionPhases
+	6,  // 5: ResponseUnitTestCase.phases:type_name -> ResponseExecutionPhases
+	1,  // 6: TranslationRequest.test_suite:type_name -> TestSuite
+	8,  // 7: TranslationRequest.target_signatures:type_name -> TargetSignature
+	14, // 8: TranslationRequest.overrides:type_name -> ConfigOverrides
+	10, // 9: TranslationRequest.budget:type_name -> Budget
+	12, // 10: ConfigOverrides.expansion_depth:type_name -> Int32Override
+	11, // 11: ConfigOverrides.early_stop:type_name -> BoolOverride
+	11, // 12: ConfigOverrides.verify_intermediate_translations:type_name -> BoolOverride
+	11, // 13: ConfigOverrides.compute_efficient_mode:type_name -> BoolOverride
+	12, // 14: ConfigOverrides.max_generated_tokens:type_name -> Int32Override
+	13, // 15: ConfigOverrides.temperature:type_name -> FloatOverride
+	13, // 16: ConfigOverrides.top_p:type_name -> FloatOverride
+	12, // 17: ConfigOverrides.top_k:type_name -> Int32Override
+	12, // 18: ConfigOverrides.seed:type_name -> Int32Override
+	12, // 19: ConfigOverrides.pan_et_al_repair_rounds:type_name -> Int32Override
+	12, // 20: ConfigOverrides.candidates_per_edge:type_name -> Int32Override
+	15, // 21: ConfigOverrides.search_strategy:type_name -> StringOverride
+	15, // 22: ConfigOverrides.search_scorer:type_name -> StringOverride
+	12, // 23: ConfigOverrides.search_width:type_name -> Int32Override
+	15, // 24: ConfigOverrides.path_ordering:type_name -> StringOverride
+	12, // 25: ConfigOverrides.max_repair_attempts:type_name -> Int32Override
+	15, // 26: ConfigOverrides.code_extraction:type_name -> StringOverride
+	11, // 27: ConfigOverrides.check_extracted_syntax:type_name -> BoolOverride
+	11, // 28: ConfigOverrides.syntax_pre_check:type_name -> BoolOverride
+	4,  // 29: ResponseTranslationEdge.fuzzy_tests:type_name -> ResponseFuzzyTestCase
+	5,  // 30: ResponseTranslationEdge.unit_tests:type_name -> ResponseUnitTestCase
+	17, // 31: ResponseTranslationEdge.usage:type_name -> ResponseTokenUsage
+	16, // 32: ResponseTranslationPath.translation_edges:type_name -> ResponseTranslationEdge
+	9,  // 33: TranslationResponse.translation_request:type_name -> TranslationRequest
+	19, // 34: TranslationResponse.paths:type_name -> ResponseTranslationPath
+	17, // 35: TranslationResponse.usage:type_name -> ResponseTokenUsage
+	18, // 36: TranslationResponse.budget_usage:type_name -> ResponseBudgetUsage
+	9,  // 37: BatchTranslationRequest.translation_requests:type_name -> TranslationRequest
+	14, // 38: BatchTranslationRequest.overrides:type_name -> ConfigOverrides
+	10, // 39: BatchTranslationRequest.budget:type_name -> Budget
+	20, // 40: BatchTranslationResponse.translation_responses:type_name -> TranslationResponse
+	17, // 41: BatchTranslationResponse.usage:type_name -> ResponseTokenUsage
+	18, // 42: BatchTranslationResponse.budget_usage:type_name -> ResponseBudgetUsage
+	16, // 43: TranslationEvent.edge:type_name -> ResponseTranslationEdge
+	20, // 44: TranslationEvent.translation_response:type_name -> TranslationResponse
+	25, // 45: LanguageStatisticsResponse.statistics:type_name -> LanguagePathStatistics
+	0,  // 46: JobStatus.status:type_name -> ResponseStatus
+	1,  // 47: VerificationRequest.test_suite:type_name -> TestSuite
+	32, // 48: VerificationResponse.verification_request:type_name -> VerificationRequest
+	4,  // 49: VerificationResponse.fuzzy_tests:type_name -> ResponseFuzzyTestCase
+	5,  // 50: VerificationResponse.unit_tests:type_name -> ResponseUnitTestCase
+	32, // 51: BatchVerificationRequest.verification_requests:type_name -> VerificationRequest
+	32, // 52: BatchVerificationResponse.verification_requests:type_name -> VerificationRequest
+	33, // 53: BatchVerificationResponse.verification_responses:type_name -> VerificationResponse
+	21, // 54: TranslationService.BatchTranslate:input_type -> BatchTranslationRequest
+	21, // 55: TranslationService.BatchTranslateStream:input_type -> BatchTranslationRequest
+	21, // 56: TranslationService.BatchTranslateCAK:input_type -> BatchTranslationRequest
+	21, // 57: TranslationService.BatchPanEtAlTranslate:input_type -> BatchTranslationRequest
+	34, // 58: TranslationService.BatchRunVerification:input_type -> BatchVerificationRequest
+	24, // 59: TranslationService.GetLanguageStatistics:input_type -> LanguageStatisticsRequest
+	21, // 60: JobService.SubmitBatch:input_type -> BatchTranslationRequest
+	27, // 61: JobService.GetJobStatus:input_type -> JobRequest
+	27, // 62: JobService.GetJobResult:input_type -> JobRequest
+	27, // 63: JobService.CancelJob:input_type -> JobRequest
+	29, // 64: InfrastructureService.LaunchInferenceEndpoint:input_type -> StartEndpointRequest
+	30, // 65: InfrastructureService.StopInferenceEndpoint:input_type -> StopEndpointRequest
+	22, // 66: TranslationService.BatchTranslate:output_type -> BatchTranslationResponse
+	23, // 67: TranslationService.BatchTranslateStream:output_type -> TranslationEvent
+	22, // 68: TranslationService.BatchTranslateCAK:output_type -> BatchTranslationResponse
+	22, // 69: TranslationService.BatchPanEtAlTranslate:output_type -> BatchTranslationResponse
+	35, // 70: TranslationService.BatchRunVerification:output_type -> BatchVerificationResponse
+	26, // 71: TranslationService.GetLanguageStatistics:output_type -> LanguageStatisticsResponse
+	28, // 72: JobService.SubmitBatch:output_type -> JobStatus
+	28, // 73: JobService.GetJobStatus:output_type -> JobStatus
+	22, // 74: JobService.GetJobResult:output_type -> BatchTranslationResponse
+	28, // 75: JobService.CancelJob:output_type -> JobStatus
+	31, // 76: InfrastructureService.LaunchInferenceEndpoint:output_type -> LaunchResponse
+	31, // 77: InfrastructureService.StopInferenceEndpoint:output_type -> LaunchResponse
+	66, // [66:78] is the sub-list for method output_type
+	54, // [54:66] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_protos_proto_init() }
@@ -1841,7 +3689,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FuzzyTestCase); i {
+			switch v := v.(*OutputComparator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1853,7 +3701,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseFuzzyTestCase); i {
+			switch v := v.(*FuzzyTestCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1865,7 +3713,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseUnitTestCase); i {
+			switch v := v.(*ResponseFuzzyTestCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1877,7 +3725,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnitTestCase); i {
+			switch v := v.(*ResponseUnitTestCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1889,7 +3737,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TargetSignature); i {
+			switch v := v.(*ResponseExecutionPhases); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1901,7 +3749,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslationRequest); i {
+			switch v := v.(*UnitTestCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1913,7 +3761,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseTranslationEdge); i {
+			switch v := v.(*TargetSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1925,7 +3773,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseTranslationPath); i {
+			switch v := v.(*TranslationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1937,7 +3785,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslationResponse); i {
+			switch v := v.(*Budget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1949,7 +3797,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchTranslationRequest); i {
+			switch v := v.(*BoolOverride); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1961,7 +3809,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchTranslationResponse); i {
+			switch v := v.(*Int32Override); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1973,7 +3821,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartEndpointRequest); i {
+			switch v := v.(*FloatOverride); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1985,7 +3833,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopEndpointRequest); i {
+			switch v := v.(*ConfigOverrides); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1997,7 +3845,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LaunchResponse); i {
+			switch v := v.(*StringOverride); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2009,7 +3857,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerificationRequest); i {
+			switch v := v.(*ResponseTranslationEdge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2021,7 +3869,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerificationResponse); i {
+			switch v := v.(*ResponseTokenUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2033,7 +3881,7 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchVerificationRequest); i {
+			switch v := v.(*ResponseBudgetUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2045,6 +3893,198 @@ func file_protos_proto_init() {
 			}
 		}
 		file_protos_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseTranslationPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTranslationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTranslationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanguageStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanguagePathStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanguageStatisticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartEndpointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopEndpointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LaunchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchVerificationResponse); i {
 			case 0:
 				return &v.state
@@ -2063,9 +4103,9 @@ func file_protos_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   35,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_protos_proto_goTypes,
 		DependencyIndexes: file_protos_proto_depIdxs,
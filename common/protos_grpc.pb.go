@@ -23,9 +23,11 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TranslationServiceClient interface {
 	BatchTranslate(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (*BatchTranslationResponse, error)
+	BatchTranslateStream(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (TranslationService_BatchTranslateStreamClient, error)
 	BatchTranslateCAK(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (*BatchTranslationResponse, error)
 	BatchPanEtAlTranslate(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (*BatchTranslationResponse, error)
 	BatchRunVerification(ctx context.Context, in *BatchVerificationRequest, opts ...grpc.CallOption) (*BatchVerificationResponse, error)
+	GetLanguageStatistics(ctx context.Context, in *LanguageStatisticsRequest, opts ...grpc.CallOption) (*LanguageStatisticsResponse, error)
 }
 
 type translationServiceClient struct {
@@ -45,6 +47,38 @@ func (c *translationServiceClient) BatchTranslate(ctx context.Context, in *Batch
 	return out, nil
 }
 
+func (c *translationServiceClient) BatchTranslateStream(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (TranslationService_BatchTranslateStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TranslationService_ServiceDesc.Streams[0], "/TranslationService/BatchTranslateStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &translationServiceBatchTranslateStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TranslationService_BatchTranslateStreamClient interface {
+	Recv() (*TranslationEvent, error)
+	grpc.ClientStream
+}
+
+type translationServiceBatchTranslateStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *translationServiceBatchTranslateStreamClient) Recv() (*TranslationEvent, error) {
+	m := new(TranslationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *translationServiceClient) BatchTranslateCAK(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (*BatchTranslationResponse, error) {
 	out := new(BatchTranslationResponse)
 	err := c.cc.Invoke(ctx, "/TranslationService/BatchTranslateCAK", in, out, opts...)
@@ -72,14 +106,25 @@ func (c *translationServiceClient) BatchRunVerification(ctx context.Context, in
 	return out, nil
 }
 
+func (c *translationServiceClient) GetLanguageStatistics(ctx context.Context, in *LanguageStatisticsRequest, opts ...grpc.CallOption) (*LanguageStatisticsResponse, error) {
+	out := new(LanguageStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/TranslationService/GetLanguageStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TranslationServiceServer is the server API for TranslationService service.
 // All implementations must embed UnimplementedTranslationServiceServer
 // for forward compatibility
 type TranslationServiceServer interface {
 	BatchTranslate(context.Context, *BatchTranslationRequest) (*BatchTranslationResponse, error)
+	BatchTranslateStream(*BatchTranslationRequest, TranslationService_BatchTranslateStreamServer) error
 	BatchTranslateCAK(context.Context, *BatchTranslationRequest) (*BatchTranslationResponse, error)
 	BatchPanEtAlTranslate(context.Context, *BatchTranslationRequest) (*BatchTranslationResponse, error)
 	BatchRunVerification(context.Context, *BatchVerificationRequest) (*BatchVerificationResponse, error)
+	GetLanguageStatistics(context.Context, *LanguageStatisticsRequest) (*LanguageStatisticsResponse, error)
 	mustEmbedUnimplementedTranslationServiceServer()
 }
 
@@ -90,6 +135,9 @@ type UnimplementedTranslationServiceServer struct {
 func (UnimplementedTranslationServiceServer) BatchTranslate(context.Context, *BatchTranslationRequest) (*BatchTranslationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchTranslate not implemented")
 }
+func (UnimplementedTranslationServiceServer) BatchTranslateStream(*BatchTranslationRequest, TranslationService_BatchTranslateStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchTranslateStream not implemented")
+}
 func (UnimplementedTranslationServiceServer) BatchTranslateCAK(context.Context, *BatchTranslationRequest) (*BatchTranslationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchTranslateCAK not implemented")
 }
@@ -99,6 +147,9 @@ func (UnimplementedTranslationServiceServer) BatchPanEtAlTranslate(context.Conte
 func (UnimplementedTranslationServiceServer) BatchRunVerification(context.Context, *BatchVerificationRequest) (*BatchVerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchRunVerification not implemented")
 }
+func (UnimplementedTranslationServiceServer) GetLanguageStatistics(context.Context, *LanguageStatisticsRequest) (*LanguageStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLanguageStatistics not implemented")
+}
 func (UnimplementedTranslationServiceServer) mustEmbedUnimplementedTranslationServiceServer() {}
 
 // UnsafeTranslationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -130,6 +181,27 @@ func _TranslationService_BatchTranslate_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TranslationService_BatchTranslateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchTranslationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TranslationServiceServer).BatchTranslateStream(m, &translationServiceBatchTranslateStreamServer{stream})
+}
+
+type TranslationService_BatchTranslateStreamServer interface {
+	Send(*TranslationEvent) error
+	grpc.ServerStream
+}
+
+type translationServiceBatchTranslateStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *translationServiceBatchTranslateStreamServer) Send(m *TranslationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _TranslationService_BatchTranslateCAK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchTranslationRequest)
 	if err := dec(in); err != nil {
@@ -184,6 +256,24 @@ func _TranslationService_BatchRunVerification_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TranslationService_GetLanguageStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LanguageStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).GetLanguageStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TranslationService/GetLanguageStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).GetLanguageStatistics(ctx, req.(*LanguageStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TranslationService_ServiceDesc is the grpc.ServiceDesc for TranslationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +297,210 @@ var TranslationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchRunVerification",
 			Handler:    _TranslationService_BatchRunVerification_Handler,
 		},
+		{
+			MethodName: "GetLanguageStatistics",
+			Handler:    _TranslationService_GetLanguageStatistics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchTranslateStream",
+			Handler:       _TranslationService_BatchTranslateStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "protos.proto",
+}
+
+// JobServiceClient is the client API for JobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobServiceClient interface {
+	SubmitBatch(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	GetJobStatus(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	GetJobResult(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*BatchTranslationResponse, error)
+	CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error)
+}
+
+type jobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobServiceClient(cc grpc.ClientConnInterface) JobServiceClient {
+	return &jobServiceClient{cc}
+}
+
+func (c *jobServiceClient) SubmitBatch(ctx context.Context, in *BatchTranslationRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/JobService/SubmitBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobStatus(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/JobService/GetJobStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobResult(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*BatchTranslationResponse, error) {
+	out := new(BatchTranslationResponse)
+	err := c.cc.Invoke(ctx, "/JobService/GetJobResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/JobService/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobServiceServer is the server API for JobService service.
+// All implementations must embed UnimplementedJobServiceServer
+// for forward compatibility
+type JobServiceServer interface {
+	SubmitBatch(context.Context, *BatchTranslationRequest) (*JobStatus, error)
+	GetJobStatus(context.Context, *JobRequest) (*JobStatus, error)
+	GetJobResult(context.Context, *JobRequest) (*BatchTranslationResponse, error)
+	CancelJob(context.Context, *JobRequest) (*JobStatus, error)
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+// UnimplementedJobServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedJobServiceServer struct {
+}
+
+func (UnimplementedJobServiceServer) SubmitBatch(context.Context, *BatchTranslationRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBatch not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobStatus(context.Context, *JobRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobResult(context.Context, *JobRequest) (*BatchTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobResult not implemented")
+}
+func (UnimplementedJobServiceServer) CancelJob(context.Context, *JobRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
+
+// UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobServiceServer will
+// result in compilation errors.
+type UnsafeJobServiceServer interface {
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+func RegisterJobServiceServer(s grpc.ServiceRegistrar, srv JobServiceServer) {
+	s.RegisterService(&JobService_ServiceDesc, srv)
+}
+
+func _JobService_SubmitBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SubmitBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/JobService/SubmitBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SubmitBatch(ctx, req.(*BatchTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/JobService/GetJobStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobStatus(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/JobService/GetJobResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobResult(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/JobService/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CancelJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "JobService",
+	HandlerType: (*JobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBatch",
+			Handler:    _JobService_SubmitBatch_Handler,
+		},
+		{
+			MethodName: "GetJobStatus",
+			Handler:    _JobService_GetJobStatus_Handler,
+		},
+		{
+			MethodName: "GetJobResult",
+			Handler:    _JobService_GetJobResult_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _JobService_CancelJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos.proto",
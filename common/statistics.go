@@ -0,0 +1,239 @@
+package common
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/badger/v4"
+	"google.golang.org/protobuf/proto"
+)
+
+// Statistics share the cache database with the other caches. The marker records that the responses cached before
+// the statistics existed were already counted
+const (
+	statisticsPrefix        = "stats/"
+	statisticsBackfilledKey = "stats_backfilled"
+)
+
+// Reasons why the paths through a sequence of languages did not find a translation
+const (
+	InferenceFailure          = "inference"
+	ExtractionFailure         = "extraction"
+	CompilationRuntimeFailure = "compilation_runtime"
+	TestFailure               = "test"
+	TimeoutFailure            = "timeout"
+)
+
+// Outcome of the paths of a request that go through a sequence of languages, starting with the seed language.
+// Failed outcomes have the reason of the first failed path
+type PathOutcome struct {
+	Languages       []string
+	Success         bool
+	FailureCategory string
+}
+
+// Requests finish concurrently, and each update reads and writes the same counters
+var statisticsLock sync.Mutex
+
+// Model names may contain slashes, so the fields of the key are separated with |
+func statisticsKey(modelName string, targetLanguage string, languages []string) string {
+	return statisticsPrefix + modelName + "|" + targetLanguage + "|" + strings.Join(languages, ">")
+}
+
+// Adds the outcomes of a request to the statistics of its model and target language
+func RecordLanguageStatistics(modelName string, targetLanguage string, outcomes []PathOutcome) error {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	statisticsLock.Lock()
+	defer statisticsLock.Unlock()
+
+	return db.Update(func(txn *badger.Txn) error {
+		for _, outcome := range outcomes {
+			key := []byte(statisticsKey(modelName, targetLanguage, outcome.Languages))
+
+			statistics := &LanguagePathStatistics{
+				ModelName:      modelName,
+				TargetLanguage: targetLanguage,
+				Languages:      outcome.Languages,
+			}
+
+			if item, err := txn.Get(key); err == nil {
+				err = item.Value(func(val []byte) error {
+					return proto.Unmarshal(val, statistics)
+				})
+
+				if err != nil {
+					return fmt.Errorf("failed to decode statistics of %s: %w", key, err)
+				}
+			} else if err != badger.ErrKeyNotFound {
+				return err
+			}
+
+			statistics.Add(outcome)
+
+			data, err := proto.Marshal(statistics)
+
+			if err != nil {
+				return fmt.Errorf("failed to serialize statistics: %w", err)
+			}
+
+			if err := txn.Set(key, data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (statistics *LanguagePathStatistics) Add(outcome PathOutcome) {
+	statistics.Attempts++
+
+	if outcome.Success {
+		statistics.Successes++
+		return
+	}
+
+	switch outcome.FailureCategory {
+	case InferenceFailure:
+		statistics.InferenceFailures++
+	case ExtractionFailure:
+		statistics.ExtractionFailures++
+	case CompilationRuntimeFailure:
+		statistics.CompilationRuntimeFailures++
+	case TestFailure:
+		statistics.TestFailures++
+	case TimeoutFailure:
+		statistics.TimeoutFailures++
+	}
+}
+
+// Success rate with one success and one failure added, so that sequences with few attempts stay close to even and
+// sequences without attempts are ranked between the ones that help and the ones that don't
+func (statistics *LanguagePathStatistics) SmoothedSuccessRate() float64 {
+	return float64(statistics.GetSuccesses()+1) / float64(statistics.GetAttempts()+2)
+}
+
+// Returns the statistics that match the fields set in the request, sorted by key
+func LoadLanguageStatistics(request *LanguageStatisticsRequest) ([]*LanguagePathStatistics, error) {
+	prefix := statisticsPrefix
+
+	if request.GetModelName() != "" {
+		prefix += request.GetModelName() + "|"
+
+		if request.GetTargetLanguage() != "" {
+			prefix += request.GetTargetLanguage() + "|"
+
+			if request.GetSeedLanguage() != "" {
+				prefix += request.GetSeedLanguage()
+			}
+		}
+	}
+
+	all := []*LanguagePathStatistics{}
+
+	err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Seek([]byte(prefix)); it.ValidForPrefix([]byte(prefix)); it.Next() {
+			statistics := &LanguagePathStatistics{}
+
+			err := it.Item().Value(func(val []byte) error {
+				return proto.Unmarshal(val, statistics)
+			})
+
+			if err != nil {
+				return fmt.Errorf("failed to decode statistics of %s: %w", it.Item().Key(), err)
+			}
+
+			if request.GetTargetLanguage() != "" && statistics.TargetLanguage != request.GetTargetLanguage() {
+				continue
+			}
+
+			if request.GetSeedLanguage() != "" && (len(statistics.Languages) == 0 || statistics.Languages[0] != request.GetSeedLanguage()) {
+				continue
+			}
+
+			if statistics.Attempts > 0 {
+				statistics.SuccessRate = float32(statistics.Successes) / float32(statistics.Attempts)
+			}
+
+			all = append(all, statistics)
+		}
+
+		return nil
+	})
+
+	return all, err
+}
+
+// Statistics of every sequence of languages tried by the model for the target language, by their joined languages
+func LoadTargetStatistics(modelName string, targetLanguage string) map[string]*LanguagePathStatistics {
+	byLanguages := make(map[string]*LanguagePathStatistics)
+
+	all, err := LoadLanguageStatistics(&LanguageStatisticsRequest{ModelName: modelName, TargetLanguage: targetLanguage})
+
+	if err != nil {
+		fmt.Println("Error reading language statistics from database")
+	}
+
+	for _, statistics := range all {
+		byLanguages[strings.Join(statistics.Languages, ">")] = statistics
+	}
+
+	return byLanguages
+}
+
+// Whether the responses cached before the statistics existed were already counted
+func StatisticsBackfilled() bool {
+	err := db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get([]byte(statisticsBackfilledKey))
+		return err
+	})
+
+	return err == nil
+}
+
+func MarkStatisticsBackfilled() error {
+	return db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(statisticsBackfilledKey), []byte{1})
+	})
+}
+
+// Calls visit with every translation response stored in the response cache. Response keys are sha256 hashes, which
+// keeps them apart from the jobs and statistics. Executions and inferences use the same keys, so the values that
+// don't decode to a response with paths are skipped
+func ForEachCachedResponse(visit func(response *TranslationResponse)) error {
+	return db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := string(it.Item().Key())
+
+			if len(key) != 64 || strings.Contains(key, "/") {
+				continue
+			}
+
+			var response TranslationResponse
+
+			err := it.Item().Value(func(val []byte) error {
+				return gob.NewDecoder(bytes.NewBuffer(val)).Decode(&response)
+			})
+
+			if err != nil || response.TranslationRequest == nil || len(response.Paths) == 0 {
+				continue
+			}
+
+			visit(&response)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package common
+
+// Tokens of an inference request, as reported by the inference server
+type TokenUsage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Estimated with the prices of the model in inferenceModels. Models without prices cost 0
+func (usage TokenUsage) EstimateCost(modelName string) float64 {
+	model := ConfigStore.InferenceModels[modelName]
+
+	return (float64(usage.PromptTokens)*model.PromptTokenPrice + float64(usage.CompletionTokens)*model.CompletionTokenPrice) / 1000000
+}
+
+func (usage TokenUsage) ToResponse(modelName string) *ResponseTokenUsage {
+	return &ResponseTokenUsage{
+		PromptTokens:     int64(usage.PromptTokens),
+		CompletionTokens: int64(usage.CompletionTokens),
+		EstimatedCost:    usage.EstimateCost(modelName),
+	}
+}
+
+func FromResponseTokenUsage(usage *ResponseTokenUsage) TokenUsage {
+	return TokenUsage{
+		PromptTokens:     int(usage.GetPromptTokens()),
+		CompletionTokens: int(usage.GetCompletionTokens()),
+	}
+}
+
+// Adds the usage to the total
+func AddTokenUsage(total *ResponseTokenUsage, usage *ResponseTokenUsage) {
+	total.PromptTokens += usage.GetPromptTokens()
+	total.CompletionTokens += usage.GetCompletionTokens()
+	total.EstimatedCost += usage.GetEstimatedCost()
+}
+
+// Edges are shared between the paths of a response, so each edge is counted once. Inferences loaded from
+// the cache did not use any tokens in this run, so they are not counted either
+func SumResponseTokenUsage(response *TranslationResponse) *ResponseTokenUsage {
+	total := &ResponseTokenUsage{}
+	counted := make(map[int32]bool)
+
+	for _, path := range response.Paths {
+		for _, edge := range path.TranslationEdges {
+			if counted[edge.EdgeId] || edge.UsedInferenceCache {
+				continue
+			}
+
+			counted[edge.EdgeId] = true
+			AddTokenUsage(total, edge.Usage)
+		}
+	}
+
+	return total
+}
+
+func SumBatchTokenUsage(responses []*TranslationResponse) *ResponseTokenUsage {
+	total := &ResponseTokenUsage{}
+
+	for _, response := range responses {
+		AddTokenUsage(total, response.Usage)
+	}
+
+	return total
+}
@@ -0,0 +1,157 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Exit code of the timeout command of the scripts when an input runs out of time
+const batchTimeoutExitCode = 124
+
+// Time for the script to report an input that ran out of time before the whole batch is stopped
+const batchTimeoutSlack = 5 * time.Second
+
+// Writes the inputs of a BATCH execution next to the program, in a directory with one file per input named after
+// its index. Returns the name of the directory, relative to the directory of the program
+func writeBatchInputs(dirPath string, fileName string, inputs []string) (string, bool) {
+	casesDir := strings.TrimSuffix(fileName, filepath.Ext(fileName)) + "_cases"
+	casesPath := filepath.Join(dirPath, casesDir)
+
+	if err := os.MkdirAll(casesPath, 0755); err != nil {
+		return "", false
+	}
+
+	for index, input := range inputs {
+		if err := os.WriteFile(filepath.Join(casesPath, strconv.Itoa(index)+".in"), []byte(input), 0644); err != nil {
+			os.RemoveAll(casesPath)
+			return "", false
+		}
+	}
+
+	return casesDir, true
+}
+
+// Each input fits in the output twice, for its stdout and its stderr, plus its header
+func batchOutputLimit(container ExecutionContainer, inputs int) int {
+	return (2*container.GetOutputLimit() + 64) * max(inputs, 1)
+}
+
+// The whole batch compiles the program once and then runs each input with the wall timeout of the language
+func batchTimeout(container ExecutionContainer, inputs int) time.Duration {
+	return container.GetTimeout() + container.GetWallTimeout()*time.Duration(max(inputs-1, 0)) + batchTimeoutSlack
+}
+
+// The script of the language prints a header for each input, "CASE <index> <exit code> <milliseconds> <stdout
+// bytes> <stderr bytes>", followed by the stdout and the stderr of the program. Inputs that the script didn't reach,
+// because the program didn't compile or the batch ran out of time, are missing from the results
+func parseBatchOutput(output string) map[int]CaseResult {
+	results := make(map[int]CaseResult)
+
+	for output != "" {
+		header, rest, found := strings.Cut(output, "\n")
+		fields := strings.Fields(header)
+
+		if !found || len(fields) != 6 || fields[0] != "CASE" {
+			break
+		}
+
+		numbers := make([]int, 5)
+
+		for index, field := range fields[1:] {
+			number, err := strconv.Atoi(field)
+
+			if err != nil || number < 0 {
+				return results
+			}
+
+			numbers[index] = number
+		}
+
+		stdoutBytes, stderrBytes := numbers[3], numbers[4]
+
+		if len(rest) < stdoutBytes+stderrBytes {
+			break
+		}
+
+		results[numbers[0]] = CaseResult{
+			ExitCode: numbers[1],
+			WallTime: time.Duration(numbers[2]) * time.Millisecond,
+			Stdout:   rest[:stdoutBytes],
+			Stderr:   rest[stdoutBytes : stdoutBytes+stderrBytes],
+		}
+
+		output = rest[stdoutBytes+stderrBytes:]
+	}
+
+	return results
+}
+
+// Results of every input of a BATCH execution, with the same output as a RUN execution of the input. Inputs that
+// were not reached get the phases and the stderr of the whole execution, such as the compilation error
+func batchCaseResults(inputs int, exitCode int, phases ExecutionPhases, stderr string, stdout string, container ExecutionContainer) []CaseResult {
+	parsed := parseBatchOutput(stdout)
+	results := make([]CaseResult, inputs)
+
+	for index := range results {
+		result, exists := parsed[index]
+
+		if exists {
+			result.Phases = ExecutionPhases{
+				CompileOutput:   phases.CompileOutput,
+				Started:         true,
+				RuntimeExitCode: result.ExitCode,
+				Signal:          signalName(result.ExitCode),
+				RuntimeStderr:   result.Stderr,
+				TimedOut:        result.ExitCode == batchTimeoutExitCode,
+			}
+		}
+
+		switch {
+		case !exists && phases.TimedOut:
+			result = CaseResult{ExitCode: -1, Output: "CMD_TIMEOUT_KILLED", Phases: phases}
+		case !exists:
+			//The script exits before running any input when the program doesn't compile
+			if exitCode == 0 {
+				exitCode = -1
+			}
+			result = CaseResult{ExitCode: exitCode, Stderr: stderr, Output: fmt.Sprintf("(Exit code: %d) %s", exitCode, stderr), Phases: phases}
+		case result.Phases.TimedOut:
+			result.Output = "CMD_TIMEOUT_KILLED"
+		case result.ExitCode != 0:
+			result.Output = fmt.Sprintf("(Exit code: %d) %s", result.ExitCode, result.Stderr)
+		default:
+			result.Output = StripPromptPrefixes(result.Stdout, container.PromptPrefixes)
+			result.Success = true
+		}
+
+		if !utf8.ValidString(result.Output) {
+			result.Output = "FAIL_INVALID_UTF8_STRING"
+		}
+
+		result.Stdout = strings.ToValidUTF8(result.Stdout, "\uFFFD")
+		result.Stderr = strings.ToValidUTF8(result.Stderr, "\uFFFD")
+		result.Phases = validPhases(result.Phases)
+
+		results[index] = result
+	}
+
+	return results
+}
+
+// A batch succeeds if every input does
+func batchSucceeded(results []CaseResult) bool {
+	for _, result := range results {
+		if !result.Success {
+			return false
+		}
+	}
+
+	return true
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/RISElabQueens/intertrans/common"
 	. "github.com/RISElabQueens/intertrans/common"
 
-	"os/exec"
 	"path/filepath"
 	"regexp"
 
@@ -87,14 +86,14 @@ func InitializeBackpressureWatchdog() {
 					rate := (globalWatchdog.inferenceCounter - globalWatchdog.executionCounter) / globalWatchdog.inferenceCounter * 100
 
 					if rate > 20 {
-						fmt.Fprintln(os.Stderr, "Warning: Backpressure detected. In the last 30 seconds, inference was %d%% faster than execution.", rate)
+						fmt.Fprintf(os.Stderr, "Warning: Backpressure detected. In the last 30 seconds, inference was %d%% faster than execution.\n", rate)
 					}
 
 				} else {
 					rate := (globalWatchdog.executionCounter - globalWatchdog.inferenceCounter) / globalWatchdog.executionCounter * 100
 
 					if rate > 20 {
-						fmt.Fprintln(os.Stderr, "Warning: Backpressure detected. In the last 30 seconds, execution was %d%% faster than inference.", rate)
+						fmt.Fprintf(os.Stderr, "Warning: Backpressure detected. In the last 30 seconds, execution was %d%% faster than inference.\n", rate)
 					}
 				}
 
@@ -212,7 +211,8 @@ func WriteCodeToFilesystem(sourceCode string, language string) (string, string,
 	}
 
 	if !exists {
-		panic(fmt.Sprintf("File extension for %s not found\n", language))
+		fmt.Printf("File extension for %s not found\n", language)
+		return "", "", "", false
 	}
 
 	fileUUID := uuid.New().String()
@@ -406,10 +406,18 @@ func StandarizeCode(executionUnit ExecutionUnit) string {
 func ExecuteCode(executionUnit ExecutionUnit) {
 
 	imageExecutorMap := GetExecutorForLanguageMap()
-	executorImage, imageExists := imageExecutorMap[executionUnit.Language]
+	executorContainer, imageExists := imageExecutorMap[executionUnit.Language]
 
 	if !imageExists {
-		panic(fmt.Sprintf("Executor image for %s not found\n", executionUnit.Language))
+		failExecution(executionUnit, fmt.Sprintf("Executor image for %s not found", executionUnit.Language))
+		return
+	}
+
+	//The request may have been cancelled while the unit was waiting in the queue
+	if executionUnit.Context().Err() != nil {
+		executionUnit.Cancelled = true
+		executionUnit.OutputChannel <- executionUnit
+		return
 	}
 
 	standardSourceCode := StandarizeCode(executionUnit)
@@ -429,117 +437,85 @@ func ExecuteCode(executionUnit ExecutionUnit) {
 	dirPath, filePath, fileName, ok := WriteCodeToFilesystem(standardSourceCode, executionUnit.Language)
 
 	if !ok {
-		panic("Could not write to filesystem")
+		failExecution(executionUnit, "Could not write to filesystem")
+		return
 	}
 
-	inContainerPath := "/code/" + fileName
-
-	// Bind the command to the context
-	var cmd *exec.Cmd
+	runner, err := GetSandboxRunner(executionUnit.Language)
 
-	// Create a context with a timeout of 90 seconds
-	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
-	defer cancel()
-
-	if executionUnit.ExecutionType == RUN {
-		cmd = exec.CommandContext(ctx, "singularity", "exec", "--memory", "4G", "--writable-tmpfs", "--no-privs", "--network", "none", "--cpus", "4", "--no-home", "--containall", "--bind", dirPath+":/code:ro", executorImage, "/bin/script", inContainerPath)
-	} else {
-		cmd = exec.CommandContext(ctx, "singularity", "exec", "--memory", "4G", "--writable-tmpfs", "--no-privs", "--network", "none", "--cpus", "4", "--no-home", "--containall", "--bind", dirPath+":/code:ro", executorImage, "/bin/script", inContainerPath, "test")
+	if err != nil {
+		os.Remove(filePath)
+		failExecution(executionUnit, err.Error())
+		return
 	}
 
-	// Maximum allowed output of a program to prevent memory exhaustation
-	const bufferSizeLimit = 1024 * 1024 // 1 MB
-	stdoutOutput := LimitedBuffer{limit: bufferSizeLimit}
-	stderrOutput := LimitedBuffer{limit: bufferSizeLimit}
-
-	cmd.Stdout = &stdoutOutput
-	cmd.Stderr = &stderrOutput
+	program := SandboxProgram{
+		Container:     executorContainer,
+		CodeDir:       dirPath,
+		FileName:      fileName,
+		ExecutionType: executionUnit.ExecutionType,
+		StdinData:     executionUnit.StdinData,
+	}
 
-	// Get the process ID (PID)
-	var pid int
+	timeout := executorContainer.GetTimeout()
+	outputLimit := executorContainer.GetOutputLimit()
+	stderrLimit := executorContainer.GetOutputLimit()
 
-	startTime := time.Now()
+	//Syntax checks run the checker command of the language instead of the program
+	if executionUnit.ExecutionType == SYNTAX_CHECK {
+		program.Command = strings.Fields(executorContainer.SyntaxCheck)
+		timeout = executorContainer.GetSyntaxCheckTimeout()
+	}
 
-	// Create a pipe to connect to the command's standard input
-	if executionUnit.StdinData != "" {
-		stdin, err := cmd.StdinPipe()
-		if err != nil {
-			fmt.Printf("Error creating stdin pipe: %v\n", err)
-			panic("Error creating stdin pipe")
-		}
+	//Batches compile the program once and run it with each of their inputs
+	if executionUnit.ExecutionType == BATCH {
+		casesDir, ok := writeBatchInputs(dirPath, fileName, executionUnit.BatchStdinData)
 
-		// Start the command
-		if err := cmd.Start(); err != nil {
-			fmt.Printf("Error starting command: %v\n", err)
+		if !ok {
+			os.Remove(filePath)
+			failExecution(executionUnit, "Could not write to filesystem")
 			return
 		}
 
-		// Get the process ID (PID)
-		pid = cmd.Process.Pid
-
-		//The program may print a prompt at startup which messes up evaluation. Clear it before continuing
-		//FIXME. It may not good to sync sleep this, performance wise
-		time.Sleep(time.Second * 3)
-		stdoutOutput.Reset()
+		defer os.RemoveAll(filepath.Join(dirPath, casesDir))
 
-		// Write the input data to the stdin pipe
-		_, err = stdin.Write([]byte(executionUnit.StdinData))
-		if err != nil {
-			fmt.Printf("Error writing to stdin: %v\n", err)
-		}
-
-		if err := stdin.Close(); err != nil {
-			fmt.Printf("Error closing stdin: %v\n", err)
-			return
-		}
-	} else {
-		// Start the command
-		if err := cmd.Start(); err != nil {
-			fmt.Printf("Error starting command: %v\n", err)
-			return
-		}
-
-		// Get the process ID (PID)
-		pid = cmd.Process.Pid
+		program.CasesDir = casesDir
+		timeout = batchTimeout(executorContainer, len(executionUnit.BatchStdinData))
+		outputLimit = batchOutputLimit(executorContainer, len(executionUnit.BatchStdinData))
+		stderrLimit = outputLimit
 	}
 
-	// Some containers hang forever, we need to stop them
-	go func() {
-		<-ctx.Done()
-		if ctx.Err() == context.DeadlineExceeded {
-			stopCmd := exec.Command("kill", "-9", fmt.Sprintf("%d", pid))
+	// Create a context with the timeout of the language. It is also done when the request is cancelled
+	ctx, cancel := context.WithTimeout(executionUnit.Context(), timeout)
+	defer cancel()
 
-			stopCmd.Start()
-			stopErr := stopCmd.Wait()
+	stdoutOutput := LimitedBuffer{limit: outputLimit}
+	stderrOutput := LimitedBuffer{limit: stderrLimit}
 
-			if stopErr != nil {
-				fmt.Printf("Couldn't kill container with PID: %s\n", fmt.Sprintf("%d", pid))
-			}
+	startTime := time.Now()
 
-		}
-	}()
+	var usage SandboxUsage
 
-	err := cmd.Wait()
+	exitCode, err := runner.Run(ctx, program, &stdoutOutput, &stderrOutput, &usage)
 
 	endTime := time.Since(startTime)
 
 	var combinedOutput string
 
-	if ctx.Err() == context.DeadlineExceeded {
+	timedOut := ctx.Err() == context.DeadlineExceeded
+	phases, stderr := executionPhases(exitCode, timedOut, stderrOutput.String())
+	executionUnit.Phases = validPhases(phases)
+
+	if executionUnit.ExecutionType == BATCH {
+		executionUnit.CaseResults = batchCaseResults(len(executionUnit.BatchStdinData), exitCode, phases, stderr, stdoutOutput.String(), executorContainer)
+		executionUnit.Success = batchSucceeded(executionUnit.CaseResults)
+	} else if timedOut {
 		combinedOutput = "CMD_TIMEOUT_KILLED"
-	} else if err != nil {
-		var exitCode int
-		if exitError, ok := err.(*exec.ExitError); ok {
-			// The command has exited with a non-zero exit code
-			exitCode = exitError.ExitCode()
-		} else {
-			// Some other error occurred
-			exitCode = -1
-		}
-		combinedOutput = fmt.Sprintf("(Exit code: %d) %s", exitCode, stderrOutput.String())
+	} else if err != nil || exitCode != 0 {
+		combinedOutput = fmt.Sprintf("(Exit code: %d) %s", exitCode, stderr)
 		executionUnit.Success = false
 	} else {
-		combinedOutput = stdoutOutput.String()
+		combinedOutput = StripPromptPrefixes(stdoutOutput.String(), executorContainer.PromptPrefixes)
 		executionUnit.Success = true
 	}
 
@@ -549,6 +525,14 @@ func ExecuteCode(executionUnit ExecutionUnit) {
 
 	executionUnit.ExecutionOutput = combinedOutput
 	executionUnit.WallTime = endTime
+	executionUnit.ExitCode = exitCode
+	executionUnit.PeakMemory = usage.PeakMemory
+	executionUnit.Stderr = strings.ToValidUTF8(stripPhaseMarkers(stderrOutput.String()), "\uFFFD")
+
+	//The stdout of a batch holds the outputs of all its inputs, which are kept in their results
+	if executionUnit.ExecutionType != BATCH {
+		executionUnit.Stdout = strings.ToValidUTF8(stdoutOutput.String(), "\uFFFD")
+	}
 
 	err = os.Remove(filePath)
 
@@ -556,6 +540,13 @@ func ExecuteCode(executionUnit ExecutionUnit) {
 		panic("Something went wrong removing a file. This shouldn't happen.")
 	}
 
+	//The program was killed before finishing, so the output must not be cached
+	if executionUnit.Context().Err() != nil {
+		executionUnit.Cancelled = true
+		executionUnit.OutputChannel <- executionUnit
+		return
+	}
+
 	globalWatchdog.CountExecution()
 
 	//This may be a transient error, so instead we ignore in that case
@@ -564,6 +555,16 @@ func ExecuteCode(executionUnit ExecutionUnit) {
 	executionUnit.OutputChannel <- executionUnit
 }
 
+// Reports an execution that could not be run because of the configuration of the server. It is not cached
+func failExecution(executionUnit ExecutionUnit, message string) {
+	fmt.Println(message)
+	executionUnit.ExecutionOutput = fmt.Sprintf("(Exit code: -1) %s", message)
+	executionUnit.ExitCode = -1
+	executionUnit.Stderr = message
+	executionUnit.Success = false
+	executionUnit.OutputChannel <- executionUnit
+}
+
 type MyRoundTripper struct {
 	r http.RoundTripper
 }
@@ -574,11 +575,19 @@ func (mrt MyRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
 }
 
 func ExecuteInference(inferenceUnit InferenceUnit) {
+	ctx := inferenceUnit.Context()
+
+	//The request may have been cancelled while the unit was waiting in the queue
+	if ctx.Err() != nil {
+		inferenceUnit.OutputChannel <- InferenceResult{Cancelled: true}
+		return
+	}
 
 	if common.ConfigStore.UseInferenceCache {
-		cacheResponse, err := LoadInferenceExistingResponse(inferenceUnit.Prompt, inferenceUnit.ModelName)
+		cacheResponse, err := LoadInferenceExistingResponse(inferenceUnit.Prompt, inferenceUnit.ModelName, inferenceUnit.GetSamples(), inferenceUnit.GetConfig())
 
-		if !err {
+		//Inferences cached without log-probabilities are generated again when they are needed
+		if !err && (!inferenceUnit.LogProbs || len(cacheResponse.LogProbs) > 0) {
 			cacheResponse.IsCached = true
 			inferenceUnit.OutputChannel <- cacheResponse
 			return
@@ -589,6 +598,9 @@ func ExecuteInference(inferenceUnit InferenceUnit) {
 	retryCount := 0
 
 	var finalResponse string
+	var finalResponses []string
+	var finalUsage TokenUsage
+	var finalLogProbs []float64
 	var startInference time.Time
 
 	for retryError {
@@ -600,21 +612,51 @@ func ExecuteInference(inferenceUnit InferenceUnit) {
 			panic("API token is empty")
 		}
 
-		response, err := GetChatCompletion(apiKey, inferenceUnit.Prompt, inferenceUnit.ModelName)
-		finalResponse = response
+		completion, err := GetCompletion(ctx, apiKey, InferenceRequest{
+			Prompt:    inferenceUnit.Prompt,
+			ModelName: inferenceUnit.ModelName,
+			N:         inferenceUnit.GetSamples(),
+			LogProbs:  inferenceUnit.LogProbs,
+			Config:    inferenceUnit.GetConfig(),
+		})
+		finalResponses = completion.Responses
+		finalUsage = completion.Usage
+		finalLogProbs = completion.LogProbs
+
+		if len(finalResponses) > 0 {
+			finalResponse = finalResponses[0]
+		}
 
-		fmt.Println(response)
+		fmt.Println(finalResponse)
 
 		if err != nil {
+			//There is no point in retrying for a cancelled request
+			if ctx.Err() != nil {
+				inferenceUnit.OutputChannel <- InferenceResult{Cancelled: true}
+				return
+			}
+
 			fmt.Println(err)
 			if retryCount < 6 {
 				retryError = true
 				retryCount++
+
+				//The load balancer sends the retry to another endpoint when one is available, otherwise the
+				//endpoints are given some time to recover
+				if IsEndpointFailure(err) && GetLoadBalancer().HasHealthyEndpoint(inferenceUnit.ModelName) {
+					continue
+				}
+
 				// This is not efficient we should remove from queue and let other task try
-				time.Sleep(10 * time.Second)
+				select {
+				case <-time.After(10 * time.Second):
+				case <-ctx.Done():
+				}
 			} else {
 				retryError = false
 				finalResponse = "INFERENCE_ERROR_RETRIED"
+				finalResponses = nil
+				finalLogProbs = nil
 				break
 			}
 
@@ -629,12 +671,19 @@ func ExecuteInference(inferenceUnit InferenceUnit) {
 	endTime := time.Since(startInference)
 
 	InferenceResult := InferenceResult{
-		Response: finalResponse,
-		IsCached: false,
-		WallTime: endTime,
-		Success:  (finalResponse != "INFERENCE_ERROR_RETRIED"),
+		Response:  finalResponse,
+		Responses: finalResponses,
+		Usage:     finalUsage,
+		LogProbs:  finalLogProbs,
+		IsCached:  false,
+		WallTime:  endTime,
+		Success:   (finalResponse != "INFERENCE_ERROR_RETRIED"),
+	}
+
+	if len(finalLogProbs) > 0 {
+		InferenceResult.LogProb = finalLogProbs[0]
 	}
 
-	SaveInferenceResponseToCache(inferenceUnit.Prompt, inferenceUnit.ModelName, InferenceResult)
+	SaveInferenceResponseToCache(inferenceUnit.Prompt, inferenceUnit.ModelName, inferenceUnit.GetSamples(), inferenceUnit.GetConfig(), InferenceResult)
 	inferenceUnit.OutputChannel <- InferenceResult
 }
@@ -0,0 +1,300 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/RISElabQueens/intertrans/common"
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Prompt to complete with the sampling settings of the request
+type InferenceRequest struct {
+	Prompt    string
+	ModelName string
+	N         int // Number of samples to generate
+	LogProbs  bool
+	Config    *AppConfig
+}
+
+// Samples generated for a request
+type Completion struct {
+	Responses []string
+	LogProbs  []float64 // Mean log-probability of the tokens of each sample. Empty if they were not requested or the API doesn't return them
+	Usage     TokenUsage
+}
+
+// Sends a request to an inference server and returns the N generated samples with the tokens used to generate
+// them. Each provider maps the sampling settings of AppConfig to the parameters of its API
+type InferenceProvider interface {
+	Complete(ctx context.Context, baseUrl string, apiKey string, request InferenceRequest) (Completion, error)
+}
+
+const (
+	OpenAIChatProviderName        = "openai_chat"
+	OpenAICompletionsProviderName = "openai_completions"
+	OllamaProviderName            = "ollama"
+	TGIProviderName               = "tgi"
+)
+
+var inferenceProviders = make(map[string]InferenceProvider)
+var inferenceProvidersLock sync.Mutex
+
+// Returns the provider configured for the model in inferenceModels. OpenAI chat completions are used by default
+func GetInferenceProvider(modelName string) (InferenceProvider, error) {
+	inferenceProvidersLock.Lock()
+	defer inferenceProvidersLock.Unlock()
+
+	providerName := common.ConfigStore.InferenceModels[modelName].Provider
+
+	if providerName == "" {
+		providerName = OpenAIChatProviderName
+	}
+
+	if provider, exists := inferenceProviders[providerName]; exists {
+		return provider, nil
+	}
+
+	var provider InferenceProvider
+
+	switch providerName {
+	case OpenAIChatProviderName:
+		provider = &OpenAIChatProvider{}
+	case OpenAICompletionsProviderName:
+		provider = &OpenAICompletionsProvider{}
+	case OllamaProviderName:
+		provider = &OllamaProvider{}
+	case TGIProviderName:
+		provider = &TGIProvider{}
+	default:
+		return nil, fmt.Errorf("inference provider %s for %s not found", providerName, modelName)
+	}
+
+	inferenceProviders[providerName] = provider
+
+	return provider, nil
+}
+
+// Sends the prompt to the endpoint of the model chosen by the load balancer, with the provider of the model
+func GetCompletion(ctx context.Context, apiKey string, request InferenceRequest) (Completion, error) {
+	provider, err := GetInferenceProvider(request.ModelName)
+
+	if err != nil {
+		return Completion{}, err
+	}
+
+	lease, err := GetLoadBalancer().Acquire(request.ModelName)
+
+	if err != nil {
+		return Completion{}, err
+	}
+
+	completion, err := provider.Complete(ctx, lease.Url, apiKey, request)
+	lease.Release(ctx, err)
+
+	return completion, err
+}
+
+// Mean of the log-probabilities of the generated tokens, which doesn't penalize longer translations
+func meanLogProb(logProbs []float64) float64 {
+	if len(logProbs) == 0 {
+		return 0
+	}
+
+	total := 0.0
+
+	for _, logProb := range logProbs {
+		total += logProb
+	}
+
+	return total / float64(len(logProbs))
+}
+
+// Seed of each call of completeEachSample, or nil if no seed is set. Each sample gets its own seed, since the same
+// seed would give every sample of a request the same completion
+func sampleSeed(config *AppConfig, sampleIndex int) *int {
+	if config.Seed == -1 {
+		return nil
+	}
+
+	seed := config.Seed + sampleIndex
+	return &seed
+}
+
+type OllamaGenerateRequest struct {
+	Model   string        `json:"model"`
+	Prompt  string        `json:"prompt"`
+	Stream  bool          `json:"stream"`
+	Options OllamaOptions `json:"options"`
+}
+
+type OllamaOptions struct {
+	NumPredict  int      `json:"num_predict,omitempty"`
+	Temperature *float32 `json:"temperature,omitempty"`
+	TopP        float32  `json:"top_p,omitempty"`
+	TopK        int      `json:"top_k,omitempty"`
+	Seed        *int     `json:"seed,omitempty"`
+}
+
+type OllamaGenerateResponse struct {
+	Model           string `json:"model"`
+	Response        string `json:"response"`
+	Done            bool   `json:"done"`
+	PromptEvalCount int    `json:"prompt_eval_count"`
+	EvalCount       int    `json:"eval_count"`
+}
+
+// APIs without a parameter for the number of samples are called once per sample, with the index of the sample
+func completeEachSample(request InferenceRequest, complete func(sampleIndex int) (Completion, error)) (Completion, error) {
+	total := Completion{}
+
+	for len(total.Responses) < request.N {
+		sample, err := complete(len(total.Responses))
+
+		if err != nil {
+			return Completion{}, err
+		}
+
+		total.Responses = append(total.Responses, sample.Responses...)
+		total.LogProbs = append(total.LogProbs, sample.LogProbs...)
+		total.Usage.PromptTokens += sample.Usage.PromptTokens
+		total.Usage.CompletionTokens += sample.Usage.CompletionTokens
+	}
+
+	return total, nil
+}
+
+// Ollama /api/generate. The base url is the address of the server without /v1 (e.g. http://localhost:11434)
+type OllamaProvider struct{}
+
+func (provider *OllamaProvider) Complete(ctx context.Context, baseUrl string, apiKey string, request InferenceRequest) (Completion, error) {
+	config := request.Config
+
+	requestBody := OllamaGenerateRequest{
+		Model:  request.ModelName,
+		Prompt: request.Prompt,
+		Stream: false,
+		Options: OllamaOptions{
+			NumPredict:  config.MaxGeneratedTokens,
+			Temperature: &config.Temperature,
+			TopP:        config.TopP,
+		},
+	}
+
+	//A top-k of -1 disables it in vLLM, but Ollama only ignores it when it is not set
+	if config.TopK > 0 {
+		requestBody.Options.TopK = config.TopK
+	}
+
+	return completeEachSample(request, func(sampleIndex int) (Completion, error) {
+		var generateResponse OllamaGenerateResponse
+
+		requestBody.Options.Seed = sampleSeed(config, sampleIndex)
+
+		if err := postJSON(ctx, baseUrl+"/api/generate", apiKey, requestBody, &generateResponse); err != nil {
+			return Completion{}, err
+		}
+
+		//Ollama doesn't return log-probabilities
+		return Completion{
+			Responses: []string{generateResponse.Response},
+			Usage: TokenUsage{
+				PromptTokens:     generateResponse.PromptEvalCount,
+				CompletionTokens: generateResponse.EvalCount,
+			},
+		}, nil
+	})
+}
+
+type TGIGenerateRequest struct {
+	Inputs     string        `json:"inputs"`
+	Parameters TGIParameters `json:"parameters"`
+}
+
+type TGIParameters struct {
+	MaxNewTokens   int      `json:"max_new_tokens,omitempty"`
+	DoSample       bool     `json:"do_sample"`
+	Temperature    *float32 `json:"temperature,omitempty"`
+	TopP           *float32 `json:"top_p,omitempty"`
+	TopK           int      `json:"top_k,omitempty"`
+	Seed           *int     `json:"seed,omitempty"`
+	ReturnFullText bool     `json:"return_full_text"`
+	Details        bool     `json:"details"`
+}
+
+type TGIGenerateResponse struct {
+	GeneratedText string     `json:"generated_text"`
+	Details       TGIDetails `json:"details"`
+}
+
+type TGIDetails struct {
+	GeneratedTokens int        `json:"generated_tokens"`
+	Tokens          []TGIToken `json:"tokens"`
+}
+
+type TGIToken struct {
+	Text    string  `json:"text"`
+	LogProb float64 `json:"logprob"`
+}
+
+// Hugging Face Text Generation Inference /generate. The model is the one loaded by the server. TGI only
+// reports the number of generated tokens, so the prompt tokens are not counted
+type TGIProvider struct{}
+
+func (provider *TGIProvider) Complete(ctx context.Context, baseUrl string, apiKey string, request InferenceRequest) (Completion, error) {
+	config := request.Config
+
+	requestBody := TGIGenerateRequest{
+		Inputs: request.Prompt,
+		Parameters: TGIParameters{
+			MaxNewTokens:   config.MaxGeneratedTokens,
+			ReturnFullText: false,
+			Details:        true,
+		},
+	}
+
+	if config.TopK > 0 {
+		requestBody.Parameters.TopK = config.TopK
+	}
+
+	//TGI rejects a temperature of 0 and a top-p of 1, greedy decoding is requested by disabling sampling instead
+	if config.Temperature > 0 {
+		requestBody.Parameters.DoSample = true
+		requestBody.Parameters.Temperature = &config.Temperature
+	}
+
+	if config.TopP > 0 && config.TopP < 1 {
+		requestBody.Parameters.TopP = &config.TopP
+	}
+
+	return completeEachSample(request, func(sampleIndex int) (Completion, error) {
+		var generateResponse TGIGenerateResponse
+
+		requestBody.Parameters.Seed = sampleSeed(config, sampleIndex)
+
+		if err := postJSON(ctx, baseUrl+"/generate", apiKey, requestBody, &generateResponse); err != nil {
+			return Completion{}, err
+		}
+
+		completion := Completion{
+			Responses: []string{generateResponse.GeneratedText},
+			Usage: TokenUsage{
+				CompletionTokens: generateResponse.Details.GeneratedTokens,
+			},
+		}
+
+		//The details with the generated tokens are always requested for the usage
+		if request.LogProbs {
+			logProbs := []float64{}
+
+			for _, token := range generateResponse.Details.Tokens {
+				logProbs = append(logProbs, token.LogProb)
+			}
+
+			completion.LogProbs = []float64{meanLogProb(logProbs)}
+		}
+
+		return completion, nil
+	})
+}
@@ -0,0 +1,280 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Live state of an inference endpoint, shared by all the models it serves
+type endpointState struct {
+	url                 string
+	inFlight            int
+	picks               int
+	latency             time.Duration // Moving average of the successful requests
+	consecutiveFailures int
+	ejectedUntil        time.Time
+	probing             bool
+}
+
+// Sends each inference request to the endpoint of the model expected to answer first, according to its in-flight
+// requests, latency and weight. Endpoints that keep failing are ejected and get a single probe request once the
+// probe interval has passed, which brings them back on success
+type LoadBalancer struct {
+	lock      sync.Mutex
+	endpoints map[string]*endpointState
+}
+
+// Endpoint leased for a single request. Release must be called with the result of the request
+type EndpointLease struct {
+	Url      string
+	balancer *LoadBalancer
+	state    *endpointState
+	probe    bool
+	started  time.Time
+}
+
+var loadBalancer = &LoadBalancer{endpoints: make(map[string]*endpointState)}
+
+func GetLoadBalancer() *LoadBalancer {
+	return loadBalancer
+}
+
+// Endpoints that can receive the requests of the model. Base urls in inferenceModels take precedence over
+// inferenceApiBaseUrls, where endpoints without models serve every model
+func ModelEndpoints(modelName string) []InferenceEndpoint {
+	if model, exists := ConfigStore.InferenceModels[modelName]; exists && len(model.BaseUrls) > 0 {
+		return model.BaseUrls
+	}
+
+	endpoints := []InferenceEndpoint{}
+
+	for _, endpoint := range ConfigStore.InferenceApiBaseUrls {
+		if endpoint.Serves(modelName) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	return endpoints
+}
+
+func (balancer *LoadBalancer) getState(url string) *endpointState {
+	state, exists := balancer.endpoints[url]
+
+	if !exists {
+		state = &endpointState{url: url}
+		balancer.endpoints[url] = state
+	}
+
+	return state
+}
+
+func (state *endpointState) isEjected() bool {
+	return state.consecutiveFailures >= ConfigStore.GetEndpointFailureThreshold()
+}
+
+// Latency assumed for the endpoints of a model before any of them has answered. Only the in-flight requests and the
+// weights matter until then, so its value is irrelevant as long as it is positive
+const defaultLatencyPrior = time.Second
+
+// Latency assumed for an endpoint without measurements, the mean latency of the endpoints of the model that have them
+func latencyPrior(states []*endpointState) time.Duration {
+	total := time.Duration(0)
+	measured := 0
+
+	for _, state := range states {
+		if state.latency > 0 {
+			total += state.latency
+			measured++
+		}
+	}
+
+	if measured == 0 {
+		return defaultLatencyPrior
+	}
+
+	return total / time.Duration(measured)
+}
+
+// Expected wait of a new request. Endpoints without measurements use the prior latency, so their in-flight requests
+// still count
+func (state *endpointState) score(weight float64, prior time.Duration) float64 {
+	latency := state.latency
+
+	if latency == 0 {
+		latency = prior
+	}
+
+	return float64(state.inFlight+1) * latency.Seconds() / weight
+}
+
+// Endpoints whose last request failed are only used when every other endpoint failed too. Ties are shared in
+// proportion to the weights
+func (state *endpointState) isBetter(weight float64, other *endpointState, otherWeight float64, prior time.Duration) bool {
+	if state.consecutiveFailures != other.consecutiveFailures {
+		return state.consecutiveFailures < other.consecutiveFailures
+	}
+
+	score, otherScore := state.score(weight, prior), other.score(otherWeight, prior)
+
+	if score != otherScore {
+		return score < otherScore
+	}
+
+	return float64(state.picks)/weight < float64(other.picks)/otherWeight
+}
+
+func (balancer *LoadBalancer) Acquire(modelName string) (*EndpointLease, error) {
+	endpoints := ModelEndpoints(modelName)
+
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no inference endpoint serves model %s", modelName)
+	}
+
+	balancer.lock.Lock()
+	defer balancer.lock.Unlock()
+
+	now := time.Now()
+	states := []*endpointState{}
+
+	for _, endpoint := range endpoints {
+		states = append(states, balancer.getState(endpoint.Url))
+	}
+
+	prior := latencyPrior(states)
+
+	var best *endpointState
+	var bestWeight float64
+	var firstBack *endpointState
+
+	for index, endpoint := range endpoints {
+		state := states[index]
+
+		if state.isEjected() {
+			//A single request checks whether the endpoint is back
+			if !state.probing && !now.Before(state.ejectedUntil) {
+				state.probing = true
+				return balancer.lease(state, true), nil
+			}
+
+			if firstBack == nil || state.ejectedUntil.Before(firstBack.ejectedUntil) {
+				firstBack = state
+			}
+			continue
+		}
+
+		if best == nil || state.isBetter(endpoint.GetWeight(), best, bestWeight, prior) {
+			best = state
+			bestWeight = endpoint.GetWeight()
+		}
+	}
+
+	//With every endpoint ejected, the request is sent to the first one expected back instead of failing
+	if best == nil {
+		best = firstBack
+	}
+
+	return balancer.lease(best, false), nil
+}
+
+func (balancer *LoadBalancer) lease(state *endpointState, probe bool) *EndpointLease {
+	state.inFlight++
+	state.picks++
+
+	return &EndpointLease{
+		Url:      state.url,
+		balancer: balancer,
+		state:    state,
+		probe:    probe,
+		started:  time.Now(),
+	}
+}
+
+// Records the result of the request. Cancelled requests and errors caused by the request itself don't count
+// against the endpoint
+func (lease *EndpointLease) Release(ctx context.Context, err error) {
+	balancer := lease.balancer
+	state := lease.state
+
+	balancer.lock.Lock()
+	defer balancer.lock.Unlock()
+
+	state.inFlight--
+
+	if lease.probe {
+		state.probing = false
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err != nil {
+		if !IsEndpointFailure(err) {
+			return
+		}
+
+		state.consecutiveFailures++
+
+		if state.isEjected() {
+			state.ejectedUntil = time.Now().Add(ConfigStore.GetEndpointProbeInterval())
+			fmt.Printf("Inference endpoint %s ejected after %d consecutive failures\n", state.url, state.consecutiveFailures)
+		}
+		return
+	}
+
+	if state.isEjected() {
+		fmt.Printf("Inference endpoint %s is back\n", state.url)
+	}
+
+	state.consecutiveFailures = 0
+
+	elapsed := time.Since(lease.started)
+
+	if state.latency == 0 {
+		state.latency = elapsed
+	} else {
+		state.latency = (4*state.latency + elapsed) / 5
+	}
+}
+
+// Whether a retry of the model can go to an endpoint whose last request did not fail
+func (balancer *LoadBalancer) HasHealthyEndpoint(modelName string) bool {
+	balancer.lock.Lock()
+	defer balancer.lock.Unlock()
+
+	for _, endpoint := range ModelEndpoints(modelName) {
+		if balancer.getState(endpoint.Url).consecutiveFailures == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Error status returned by an inference server
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (err *StatusError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", err.StatusCode, err.Body)
+}
+
+// Connection errors, overloaded servers and server errors are failures of the endpoint. Other statuses, such as a
+// prompt longer than the context of the model, would fail on every endpoint
+func IsEndpointFailure(err error) bool {
+	var statusError *StatusError
+
+	if errors.As(err, &statusError) {
+		return statusError.StatusCode == http.StatusTooManyRequests || statusError.StatusCode >= 500
+	}
+
+	return true
+}
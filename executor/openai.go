@@ -2,11 +2,11 @@ package executor
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"sync"
 
 	"github.com/RISElabQueens/intertrans/common"
 )
@@ -20,8 +20,9 @@ type ChatCompletionRequest struct {
 	Temperature       float32                `json:"temperature,omitempty"`
 	TopP              float32                `json:"top_p,omitempty"`
 	TopK              int                    `json:"top_k,omitempty"`
-	Seed              int                    `json:"seed,omitempty"`
+	Seed              *int                   `json:"seed,omitempty"`
 	N                 int                    `json:"n,omitempty"`
+	Logprobs          bool                   `json:"logprobs,omitempty"`
 }
 
 type Message struct {
@@ -34,100 +35,221 @@ type ChatCompletionResponse struct {
 	Object  string   `json:"object"`
 	Created int      `json:"created"`
 	Choices []Choice `json:"choices"`
+	Usage   Usage    `json:"usage"`
+}
+
+// Tokens of the whole request, including all the choices
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+func (usage Usage) toTokenUsage() common.TokenUsage {
+	return common.TokenUsage{
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+	}
 }
 
 type Choice struct {
-	Index        int     `json:"index"`
-	Message      Message `json:"message"`
-	FinishReason string  `json:"finish_reason"`
+	Index        int             `json:"index"`
+	Message      Message         `json:"message"`
+	FinishReason string          `json:"finish_reason"`
+	Logprobs     *ChoiceLogprobs `json:"logprobs"`
 }
 
-type RoundRobinApiCaller struct {
-	currentIndex int
-	baseUrls     []string
+type ChoiceLogprobs struct {
+	Content []TokenLogprob `json:"content"`
 }
 
-var roundRobinApiCaller *RoundRobinApiCaller
-var roundRobinMutex sync.Mutex
+type TokenLogprob struct {
+	Token   string  `json:"token"`
+	Logprob float64 `json:"logprob"`
+}
 
-func GetRoundRobin() *RoundRobinApiCaller {
-	roundRobinMutex.Lock()
-	if roundRobinApiCaller == nil {
-		roundRobinApiCaller = &RoundRobinApiCaller{
-			currentIndex: 0,
-			baseUrls:     common.ConfigStore.InferenceApiBaseUrls,
-		}
-	}
-	roundRobinMutex.Unlock()
-	return roundRobinApiCaller
+// Legacy completions send the prompt as is, which base models without a chat template need
+type CompletionRequest struct {
+	Model             string  `json:"model"`
+	Prompt            string  `json:"prompt"`
+	MaxTokens         int     `json:"max_tokens,omitempty"`
+	SkipSpecialTokens bool    `json:"skip_special_tokens,omitempty"`
+	Temperature       float32 `json:"temperature,omitempty"`
+	TopP              float32 `json:"top_p,omitempty"`
+	TopK              int     `json:"top_k,omitempty"`
+	Seed              *int    `json:"seed,omitempty"`
+	N                 int     `json:"n,omitempty"`
+	Logprobs          *int    `json:"logprobs,omitempty"`
 }
 
-func (roundRobinApiCaller *RoundRobinApiCaller) GetNext() string {
-	roundRobinMutex.Lock()
-	returned := roundRobinApiCaller.baseUrls[roundRobinApiCaller.currentIndex]
-	roundRobinApiCaller.currentIndex++
+type CompletionResponse struct {
+	ID      string             `json:"id"`
+	Object  string             `json:"object"`
+	Created int                `json:"created"`
+	Choices []CompletionChoice `json:"choices"`
+	Usage   Usage              `json:"usage"`
+}
 
-	if roundRobinApiCaller.currentIndex == len(roundRobinApiCaller.baseUrls) {
-		roundRobinApiCaller.currentIndex = 0
-	}
-	roundRobinMutex.Unlock()
-	return returned
+type CompletionChoice struct {
+	Index        int                 `json:"index"`
+	Text         string              `json:"text"`
+	FinishReason string              `json:"finish_reason"`
+	Logprobs     *CompletionLogprobs `json:"logprobs"`
 }
 
-func GetChatCompletion(apiKey, message string, modelName string) (string, error) {
-	var requestBody ChatCompletionRequest
-	if common.ConfigStore.Seed != -1 {
-		requestBody = ChatCompletionRequest{
-			Model: modelName,
-			Messages: []Message{
-				{
-					Role:    "user",
-					Content: message,
-				},
-			},
-			MaxTokens:   common.ConfigStore.MaxGeneratedTokens,
-			Temperature: common.ConfigStore.Temperature,
-			TopP:        common.ConfigStore.TopP,
-		}
+type CompletionLogprobs struct {
+	TokenLogprobs []*float64 `json:"token_logprobs"`
+}
 
-		if common.ConfigStore.InferenceBackend == "vllm" {
-			requestBody.SkipSpecialTokens = true
-			requestBody.TopK = common.ConfigStore.TopK
-			requestBody.Seed = common.ConfigStore.Seed
-		}
+// OpenAI compatible /chat/completions. vLLM specific parameters are added with inferenceBackend: vllm
+type OpenAIChatProvider struct{}
 
-	} else {
-		requestBody = ChatCompletionRequest{
-			Model: modelName,
-			Messages: []Message{
-				{
-					Role:    "user",
-					Content: message,
-				},
+func (provider *OpenAIChatProvider) Complete(ctx context.Context, baseUrl string, apiKey string, request InferenceRequest) (Completion, error) {
+	config := request.Config
+
+	requestBody := ChatCompletionRequest{
+		Model: request.ModelName,
+		Messages: []Message{
+			{
+				Role:    "user",
+				Content: request.Prompt,
 			},
-			MaxTokens:   common.ConfigStore.MaxGeneratedTokens,
-			Temperature: common.ConfigStore.Temperature,
-			TopP:        common.ConfigStore.TopP,
+		},
+		MaxTokens:   config.MaxGeneratedTokens,
+		Temperature: config.Temperature,
+		TopP:        config.TopP,
+		N:           request.N,
+		Logprobs:    request.LogProbs,
+	}
+
+	//Other OpenAI compatible servers may reject the seed or the top-k
+	if common.ConfigStore.InferenceBackend == "vllm" {
+		requestBody.SkipSpecialTokens = true
+		requestBody.TopK = config.TopK
+		requestBody.Seed = &config.Seed
+	}
+
+	var completionResponse ChatCompletionResponse
+
+	if err := postJSON(ctx, baseUrl+"/chat/completions", apiKey, requestBody, &completionResponse); err != nil {
+		return Completion{}, err
+	}
+
+	if len(completionResponse.Choices) == 0 {
+		return Completion{}, fmt.Errorf("no choices found in response")
+	}
+
+	//Choices are not guaranteed to be sorted by index
+	completion := newCompletion(len(completionResponse.Choices), request.LogProbs, completionResponse.Usage)
+
+	for position, choice := range completionResponse.Choices {
+		index := choiceIndex(choice.Index, position, len(completion.Responses))
+		completion.Responses[index] = choice.Message.Content
+
+		if request.LogProbs && choice.Logprobs != nil {
+			logProbs := []float64{}
+
+			for _, token := range choice.Logprobs.Content {
+				logProbs = append(logProbs, token.Logprob)
+			}
+
+			completion.LogProbs[index] = meanLogProb(logProbs)
 		}
+	}
+
+	return completion, nil
+}
+
+// OpenAI compatible legacy /completions, for models that only expose a completions endpoint
+type OpenAICompletionsProvider struct{}
+
+func (provider *OpenAICompletionsProvider) Complete(ctx context.Context, baseUrl string, apiKey string, request InferenceRequest) (Completion, error) {
+	config := request.Config
+
+	requestBody := CompletionRequest{
+		Model:       request.ModelName,
+		Prompt:      request.Prompt,
+		MaxTokens:   config.MaxGeneratedTokens,
+		Temperature: config.Temperature,
+		TopP:        config.TopP,
+		N:           request.N,
+	}
+
+	//Only the log-probability of the generated token is needed, not the alternatives
+	if request.LogProbs {
+		requestBody.Logprobs = new(int)
+	}
+
+	if common.ConfigStore.InferenceBackend == "vllm" {
+		requestBody.SkipSpecialTokens = true
+		requestBody.TopK = config.TopK
+		requestBody.Seed = &config.Seed
+	}
+
+	var completionResponse CompletionResponse
+
+	if err := postJSON(ctx, baseUrl+"/completions", apiKey, requestBody, &completionResponse); err != nil {
+		return Completion{}, err
+	}
+
+	if len(completionResponse.Choices) == 0 {
+		return Completion{}, fmt.Errorf("no choices found in response")
+	}
+
+	completion := newCompletion(len(completionResponse.Choices), request.LogProbs, completionResponse.Usage)
+
+	for position, choice := range completionResponse.Choices {
+		index := choiceIndex(choice.Index, position, len(completion.Responses))
+		completion.Responses[index] = choice.Text
 
-		if common.ConfigStore.InferenceBackend == "vllm" {
-			requestBody.SkipSpecialTokens = true
-			requestBody.TopK = common.ConfigStore.TopK
+		if request.LogProbs && choice.Logprobs != nil {
+			logProbs := []float64{}
+
+			for _, logProb := range choice.Logprobs.TokenLogprobs {
+				if logProb != nil {
+					logProbs = append(logProbs, *logProb)
+				}
+			}
+
+			completion.LogProbs[index] = meanLogProb(logProbs)
 		}
 	}
 
-	baseUrl := GetRoundRobin().GetNext()
-	openaiURL := baseUrl + "/chat/completions"
+	return completion, nil
+}
+
+func newCompletion(choices int, logProbs bool, usage Usage) Completion {
+	completion := Completion{
+		Responses: make([]string, choices),
+		Usage:     usage.toTokenUsage(),
+	}
+
+	if logProbs {
+		completion.LogProbs = make([]float64, choices)
+	}
 
+	return completion
+}
+
+// Some servers don't set the index of the choices, in which case their position is used
+func choiceIndex(index int, position int, total int) int {
+	if index < 0 || index >= total {
+		return position
+	}
+	return index
+}
+
+// Shared by the providers to send a request and decode its JSON response
+func postJSON(ctx context.Context, url string, apiKey string, requestBody interface{}, response interface{}) error {
 	requestBodyBytes, err := json.Marshal(requestBody)
 
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal request body: %v", err)
+		return fmt.Errorf("failed to marshal request body: %v", err)
 	}
 
-	req, err := http.NewRequest("POST", openaiURL, bytes.NewBuffer(requestBodyBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBodyBytes))
 	if err != nil {
-		return "", fmt.Errorf("failed to create HTTP request: %v", err)
+		return fmt.Errorf("failed to create HTTP request: %v", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -136,30 +258,24 @@ func GetChatCompletion(apiKey, message string, modelName string) (string, error)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to perform HTTP request: %v", err)
+		return fmt.Errorf("failed to perform HTTP request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %v", err)
+		return fmt.Errorf("failed to read response body: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		error_msg := fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(body))
+		error_msg := &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
 		fmt.Println(error_msg)
-		return "", error_msg
-	}
-
-	var completionResponse ChatCompletionResponse
-	if err := json.Unmarshal(body, &completionResponse); err != nil {
-		return "", fmt.Errorf("failed to unmarshal response body: %v", err)
+		return error_msg
 	}
 
-	if len(completionResponse.Choices) > 0 {
-		response := completionResponse.Choices[0].Message.Content
-		return response, nil
+	if err := json.Unmarshal(body, response); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %v", err)
 	}
 
-	return "", fmt.Errorf("no choices found in response")
+	return nil
 }
@@ -0,0 +1,75 @@
+package executor
+
+import (
+	"strings"
+	"syscall"
+
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Lines that the scripts of the languages print to stderr when they start compiling and running the program
+const (
+	compilePhaseMarker = "__INTERTRANS_COMPILE__\n"
+	runPhaseMarker     = "__INTERTRANS_RUN__\n"
+)
+
+// Shells exit with 128 plus the number of the signal that killed the program
+const signalExitCodeBase = 128
+
+// Phases reached by a script that exited with exitCode, found from the markers in its stderr. Also returns the
+// stderr without the markers, which is the output of the compiler if the program didn't start, and the stderr of
+// the program otherwise
+func executionPhases(exitCode int, timedOut bool, stderr string) (ExecutionPhases, string) {
+	phases := ExecutionPhases{TimedOut: timedOut}
+
+	beforeCompile, compileAndRun, compiling := strings.Cut(stderr, compilePhaseMarker)
+
+	if !compiling {
+		compileAndRun = stderr
+		beforeCompile = ""
+	}
+
+	compileOutput, runtimeStderr, running := strings.Cut(compileAndRun, runPhaseMarker)
+
+	//Scripts that don't report their phases only tell that they failed
+	if !compiling && !running {
+		phases.RuntimeExitCode = exitCode
+		phases.RuntimeStderr = stderr
+		return phases, stderr
+	}
+
+	if !running {
+		phases.CompileOutput = beforeCompile + compileAndRun
+		phases.CompileFailed = !timedOut && exitCode != 0
+		return phases, phases.CompileOutput
+	}
+
+	phases.CompileOutput = compileOutput
+	phases.Started = true
+	phases.RuntimeExitCode = exitCode
+	phases.Signal = signalName(exitCode)
+	phases.RuntimeStderr = beforeCompile + runtimeStderr
+
+	return phases, phases.RuntimeStderr
+}
+
+// The outputs of the phases are returned as strings, which must be valid UTF-8
+func validPhases(phases ExecutionPhases) ExecutionPhases {
+	phases.CompileOutput = strings.ToValidUTF8(phases.CompileOutput, "\uFFFD")
+	phases.RuntimeStderr = strings.ToValidUTF8(phases.RuntimeStderr, "\uFFFD")
+	return phases
+}
+
+// Stderr of a script as printed by the compiler and the program
+func stripPhaseMarkers(stderr string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(stderr, compilePhaseMarker, ""), runPhaseMarker, "")
+}
+
+// Name of the signal that killed a program that exited with exitCode, or "" if it exited on its own
+func signalName(exitCode int) string {
+	if exitCode <= signalExitCodeBase || exitCode >= signalExitCodeBase+65 {
+		return ""
+	}
+
+	return syscall.Signal(exitCode - signalExitCodeBase).String()
+}
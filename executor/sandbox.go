@@ -0,0 +1,333 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/RISElabQueens/intertrans/common"
+	. "github.com/RISElabQueens/intertrans/common"
+)
+
+// Program written to the filesystem that a sandbox should execute
+type SandboxProgram struct {
+	Container     ExecutionContainer // Entry of executionContainers for the language. The meaning of the image depends on the runner
+	CodeDir       string             // Directory in the host containing the program. Runners mount it read-only at /code
+	FileName      string
+	ExecutionType ExecutionType
+	StdinData     string
+	Command       []string // Optional, runs this command instead of the script of the language. {file} is the program
+	CasesDir      string   // Directory of the inputs of a BATCH execution, relative to CodeDir
+}
+
+// Runs a program in a sandbox until it exits or the context is done. Returns the exit code of the program.
+// An error means that the program could not be run to completion, in which case the exit code is -1
+type SandboxRunner interface {
+	Run(ctx context.Context, program SandboxProgram, stdout *LimitedBuffer, stderr *LimitedBuffer, usage *SandboxUsage) (int, error)
+}
+
+// Resources used by a program. Runners that can't measure a resource leave it at 0
+type SandboxUsage struct {
+	PeakMemory int64 // Bytes of the largest resident set of the program or of the processes it waited for
+}
+
+const (
+	SingularityRunnerName = "singularity"
+	DockerRunnerName      = "docker"
+	BubblewrapRunnerName  = "bubblewrap"
+	LocalRunnerName       = "local"
+)
+
+var sandboxRunners = make(map[string]SandboxRunner)
+var sandboxRunnersLock sync.Mutex
+
+// Returns the runner configured for the language in executionRunners. Singularity is used by default
+func GetSandboxRunner(language string) (SandboxRunner, error) {
+	sandboxRunnersLock.Lock()
+	defer sandboxRunnersLock.Unlock()
+
+	runnerName, exists := common.ConfigStore.ExecutionRunners[language]
+
+	if !exists || runnerName == "" {
+		runnerName = SingularityRunnerName
+	}
+
+	if runner, exists := sandboxRunners[runnerName]; exists {
+		return runner, nil
+	}
+
+	var runner SandboxRunner
+
+	switch runnerName {
+	case SingularityRunnerName:
+		runner = &SingularityRunner{}
+	case DockerRunnerName:
+		runner = &DockerRunner{}
+	case BubblewrapRunnerName:
+		runner = &BubblewrapRunner{}
+	case LocalRunnerName:
+		fmt.Println("Warning: Generated code is executed without a sandbox by the local runner. Only use it for development.")
+		runner = &LocalRunner{}
+	default:
+		return nil, fmt.Errorf("execution runner %s for %s not found", runnerName, language)
+	}
+
+	sandboxRunners[runnerName] = runner
+
+	return runner, nil
+}
+
+// Checks that code in the language can be written to the filesystem and executed
+func CheckLanguageSupport(language string) error {
+	if _, exists := GetFileExtensionsMap()[language]; !exists {
+		return fmt.Errorf("file extension for %s not found", language)
+	}
+
+	if _, exists := GetExecutorForLanguageMap()[language]; !exists {
+		return fmt.Errorf("executor image for %s not found", language)
+	}
+
+	_, err := GetSandboxRunner(language)
+
+	return err
+}
+
+// Extra bind mounts use the same format as Docker and Singularity: host_path:container_path[:ro]
+func parseBindMount(mount string) (string, string, bool) {
+	parts := strings.Split(mount, ":")
+	readOnly := len(parts) > 2 && parts[2] == "ro"
+
+	if len(parts) == 1 {
+		return parts[0], parts[0], readOnly
+	}
+
+	return parts[0], parts[1], readOnly
+}
+
+func scriptArguments(program SandboxProgram, codeDir string) []string {
+	arguments := []string{filepath.Join(codeDir, program.FileName)}
+
+	if program.ExecutionType == TEST {
+		arguments = append(arguments, "test")
+	}
+
+	//The script runs the program with each input within the wall timeout and keeps up to outputLimit bytes of it
+	if program.ExecutionType == BATCH {
+		wallTimeout := strconv.Itoa(int(program.Container.GetWallTimeout().Seconds()))
+		arguments = append(arguments, "batch", filepath.Join(codeDir, program.CasesDir), wallTimeout, strconv.Itoa(program.Container.GetOutputLimit()))
+	}
+
+	return arguments
+}
+
+// Command that runs the program in the sandbox, where the script of the language is at script and the program is
+// in codeDir
+func programCommand(program SandboxProgram, script string, codeDir string) []string {
+	if len(program.Command) == 0 {
+		return append([]string{script}, scriptArguments(program, codeDir)...)
+	}
+
+	command := []string{}
+
+	for _, argument := range program.Command {
+		command = append(command, strings.ReplaceAll(argument, "{file}", filepath.Join(codeDir, program.FileName)))
+	}
+
+	return command
+}
+
+// Runs the program in a Singularity container. The image is the path of the .sif file
+type SingularityRunner struct{}
+
+func (runner *SingularityRunner) Run(ctx context.Context, program SandboxProgram, stdout *LimitedBuffer, stderr *LimitedBuffer, usage *SandboxUsage) (int, error) {
+	container := program.Container
+	arguments := []string{"exec", "--memory", container.GetMemory(), "--writable-tmpfs", "--no-privs", "--network", "none", "--cpus", strconv.FormatFloat(container.GetCpus(), 'f', -1, 64), "--no-home", "--containall", "--bind", program.CodeDir + ":/code:ro"}
+
+	for _, mount := range container.BindMounts {
+		arguments = append(arguments, "--bind", mount)
+	}
+
+	arguments = append(arguments, container.Image)
+	arguments = append(arguments, programCommand(program, "/bin/script", "/code")...)
+
+	return runCommand(ctx, exec.CommandContext(ctx, "singularity", arguments...), program, stdout, stderr, usage)
+}
+
+// Runs the program with bubblewrap in new namespaces without network access. The image is the path of the
+// language script in the host (e.g. docker/python3/script), and the host filesystem is mounted read-only with a
+// private /tmp for each execution. Memory and CPU limits are not supported by bubblewrap
+type BubblewrapRunner struct{}
+
+func (runner *BubblewrapRunner) Run(ctx context.Context, program SandboxProgram, stdout *LimitedBuffer, stderr *LimitedBuffer, usage *SandboxUsage) (int, error) {
+	script, err := filepath.Abs(program.Container.Image)
+
+	if err != nil {
+		return -1, err
+	}
+
+	arguments := []string{"--ro-bind", "/", "/", "--dev", "/dev", "--proc", "/proc", "--tmpfs", "/tmp", "--ro-bind", program.CodeDir, "/code"}
+
+	for _, mount := range program.Container.BindMounts {
+		source, destination, readOnly := parseBindMount(mount)
+
+		if readOnly {
+			arguments = append(arguments, "--ro-bind", source, destination)
+		} else {
+			arguments = append(arguments, "--bind", source, destination)
+		}
+	}
+
+	arguments = append(arguments, "--unshare-all", "--die-with-parent", "--new-session")
+	arguments = append(arguments, programCommand(program, script, "/code")...)
+
+	return runCommand(ctx, exec.CommandContext(ctx, "bwrap", arguments...), program, stdout, stderr, usage)
+}
+
+// Runs the program as a regular process of the host. The image is the path of the language script in the host.
+// There is no isolation at all, so this is only meant for development. Each execution gets its own TMPDIR, where
+// the scripts create their working directory, which is removed once it finishes
+type LocalRunner struct{}
+
+func (runner *LocalRunner) Run(ctx context.Context, program SandboxProgram, stdout *LimitedBuffer, stderr *LimitedBuffer, usage *SandboxUsage) (int, error) {
+	tempDir, err := os.MkdirTemp("", "intertrans_local_")
+
+	if err != nil {
+		return -1, err
+	}
+
+	defer os.RemoveAll(tempDir)
+
+	command := programCommand(program, program.Container.Image, program.CodeDir)
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Dir = program.CodeDir
+	cmd.Env = append(os.Environ(), "TMPDIR="+tempDir)
+
+	return runCommand(ctx, cmd, program, stdout, stderr, usage)
+}
+
+// Shared by the runners that execute the sandbox as a command in the host
+func runCommand(ctx context.Context, cmd *exec.Cmd, program SandboxProgram, stdout *LimitedBuffer, stderr *LimitedBuffer, usage *SandboxUsage) (int, error) {
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	var stdin io.WriteCloser
+
+	// Create a pipe to connect to the command's standard input
+	if program.StdinData != "" {
+		var err error
+		stdin, err = cmd.StdinPipe()
+
+		if err != nil {
+			fmt.Printf("Error creating stdin pipe: %v\n", err)
+			return -1, err
+		}
+	}
+
+	// Start the command
+	if err := cmd.Start(); err != nil {
+		fmt.Printf("Error starting command: %v\n", err)
+		return -1, err
+	}
+
+	// Get the process ID (PID)
+	pid := cmd.Process.Pid
+
+	if stdin != nil {
+		if err := feedStdin(stdin, stdin.Close, program.StdinData); err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	// Some containers hang forever, we need to stop them
+	finished := make(chan struct{})
+	defer close(finished)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-finished:
+			return
+		}
+
+		stopCmd := exec.Command("kill", "-9", fmt.Sprintf("%d", pid))
+
+		stopCmd.Start()
+		stopErr := stopCmd.Wait()
+
+		if stopErr != nil {
+			fmt.Printf("Couldn't kill container with PID: %d\n", pid)
+		}
+	}()
+
+	err := cmd.Wait()
+
+	//Linux reports the maximum resident set size in kilobytes
+	if cmd.ProcessState != nil {
+		if rusage, ok := cmd.ProcessState.SysUsage().(*syscall.Rusage); ok {
+			usage.PeakMemory = rusage.Maxrss * 1024
+		}
+	}
+
+	if err != nil {
+		var exitError *exec.ExitError
+
+		// The command has exited with a non-zero exit code
+		if errors.As(err, &exitError) {
+			return exitError.ExitCode(), nil
+		}
+
+		return -1, err
+	}
+
+	return 0, nil
+}
+
+// Writes the input of a program as soon as it has started. Prompts printed while waiting for the input are
+// removed from the output afterwards with StripPromptPrefixes
+func feedStdin(stdin io.Writer, closeStdin func() error, data string) error {
+	// Write the input data to the stdin pipe
+	if _, err := stdin.Write([]byte(data)); err != nil {
+		fmt.Printf("Error writing to stdin: %v\n", err)
+	}
+
+	if err := closeStdin(); err != nil {
+		return fmt.Errorf("error closing stdin: %w", err)
+	}
+
+	return nil
+}
+
+// Removes the known prompts (e.g. "Enter a number: ") that a program prints at the start of a line before reading its input
+func StripPromptPrefixes(output string, promptPrefixes []string) string {
+	if len(promptPrefixes) == 0 {
+		return output
+	}
+
+	lines := strings.SplitAfter(output, "\n")
+
+	for index, line := range lines {
+		//A program may print several prompts in a row before the actual output
+		for stripped := true; stripped; {
+			stripped = false
+
+			for _, prefix := range promptPrefixes {
+				if prefix != "" && strings.HasPrefix(line, prefix) {
+					line = strings.TrimPrefix(line, prefix)
+					stripped = true
+				}
+			}
+		}
+
+		lines[index] = line
+	}
+
+	return strings.Join(lines, "")
+}
@@ -0,0 +1,129 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	units "github.com/docker/go-units"
+)
+
+// Runs the program in a Docker container. The image is the name of an image built with docker/build.sh. The
+// memory used by the container is not measured
+type DockerRunner struct {
+	cli  *client.Client
+	once sync.Once
+	err  error
+}
+
+func (runner *DockerRunner) client() (*client.Client, error) {
+	runner.once.Do(func() {
+		runner.cli, runner.err = client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	})
+
+	return runner.cli, runner.err
+}
+
+func (runner *DockerRunner) Run(ctx context.Context, program SandboxProgram, stdout *LimitedBuffer, stderr *LimitedBuffer, usage *SandboxUsage) (int, error) {
+	cli, err := runner.client()
+
+	if err != nil {
+		return -1, fmt.Errorf("failed to connect to docker: %w", err)
+	}
+
+	hasStdin := program.StdinData != ""
+	executionContainer := program.Container
+
+	memory, err := units.RAMInBytes(executionContainer.GetMemory())
+
+	if err != nil {
+		return -1, fmt.Errorf("invalid memory limit %s: %w", executionContainer.GetMemory(), err)
+	}
+
+	config := &container.Config{
+		Image:           executionContainer.Image,
+		Cmd:             programCommand(program, "/bin/script", "/code"),
+		AttachStdin:     hasStdin,
+		AttachStdout:    true,
+		AttachStderr:    true,
+		OpenStdin:       hasStdin,
+		StdinOnce:       hasStdin,
+		NetworkDisabled: true,
+	}
+
+	hostConfig := &container.HostConfig{
+		Binds:       append([]string{program.CodeDir + ":/code:ro"}, executionContainer.BindMounts...),
+		NetworkMode: "none",
+		Resources: container.Resources{
+			Memory:   memory,
+			NanoCPUs: int64(executionContainer.GetCpus() * 1000000000),
+		},
+	}
+
+	created, err := cli.ContainerCreate(ctx, config, hostConfig, nil, nil, "")
+
+	if err != nil {
+		return -1, fmt.Errorf("failed to create container: %w", err)
+	}
+
+	//The request context may be done already, but the container must be removed anyway
+	defer cli.ContainerRemove(context.Background(), created.ID, container.RemoveOptions{Force: true})
+
+	attached, err := cli.ContainerAttach(ctx, created.ID, container.AttachOptions{
+		Stream: true,
+		Stdin:  hasStdin,
+		Stdout: true,
+		Stderr: true,
+	})
+
+	if err != nil {
+		return -1, fmt.Errorf("failed to attach to container: %w", err)
+	}
+	defer attached.Close()
+
+	//Stdout and stderr are multiplexed in the same stream. Once a buffer is full the rest of the output is discarded,
+	//so the program doesn't block writing to the attached stream until the wall timeout
+	copied := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(stdout, stderr, attached.Reader)
+
+		if err != nil {
+			io.Copy(io.Discard, attached.Reader)
+		}
+
+		copied <- err
+	}()
+
+	if err := cli.ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+		return -1, fmt.Errorf("failed to start container: %w", err)
+	}
+
+	if hasStdin {
+		if err := feedStdin(attached.Conn, attached.CloseWrite, program.StdinData); err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	statusChannel, errChannel := cli.ContainerWait(ctx, created.ID, container.WaitConditionNotRunning)
+
+	select {
+	case status := <-statusChannel:
+		//Make sure all the output was copied before returning
+		if err := <-copied; err != nil {
+			return -1, err
+		}
+
+		return int(status.StatusCode), nil
+	case err := <-errChannel:
+		// Some containers hang forever, we need to stop them
+		if ctx.Err() != nil {
+			cli.ContainerKill(context.Background(), created.ID, "KILL")
+		}
+
+		return -1, err
+	}
+}
@@ -0,0 +1,33 @@
+package executor
+
+import (
+	"go/parser"
+	"go/token"
+)
+
+// Checks that source code is well formed without compiling or running it
+type SyntaxChecker interface {
+	Check(sourceCode string) error
+}
+
+// Returns the syntax checker of the language, if there is one
+func GetSyntaxChecker(language string) (SyntaxChecker, bool) {
+	switch language {
+	case "Go":
+		return &GoSyntaxChecker{}, true
+	default:
+		return nil, false
+	}
+}
+
+// Parses the code with go/parser. Models often leave out the package clause, so package main is added if missing
+type GoSyntaxChecker struct{}
+
+func (checker *GoSyntaxChecker) Check(sourceCode string) error {
+	if _, err := parser.ParseFile(token.NewFileSet(), "", sourceCode, parser.PackageClauseOnly); err != nil {
+		sourceCode = "package main\n" + sourceCode
+	}
+
+	_, err := parser.ParseFile(token.NewFileSet(), "", sourceCode, parser.AllErrors)
+	return err
+}
@@ -19,25 +19,134 @@ type TranslationServer struct {
 	common.UnimplementedTranslationServiceServer
 }
 
+type JobServer struct {
+	common.UnimplementedJobServiceServer
+}
+
 type InfrastructureServer struct {
 	common.UnimplementedInfrastructureServiceServer
 }
 
 const (
 	maxMsgSize = 1000 * 1024 * 1024 * 2 // 2GB
+
+	streamEventBuffer = 1024 // Events of BatchTranslateStream waiting to be sent
 )
 
 func (m *TranslationServer) BatchTranslate(ctx context.Context, request *common.BatchTranslationRequest) (*common.BatchTranslationResponse, error) {
-	return algo.InterTrans(request), nil
+	common.ApplyBatchOverrides(request)
+
+	if err := algo.ValidateTranslationBatch(request, algo.ValidateInterTransRequest); err != nil {
+		return nil, err
+	}
+
+	return algo.InterTrans(ctx, request, nil), nil
+}
+
+// Same as BatchTranslate, but streams the edges as their status changes and each TranslationResponse as soon as it is ready
+func (m *TranslationServer) BatchTranslateStream(request *common.BatchTranslationRequest, stream common.TranslationService_BatchTranslateStreamServer) error {
+	common.ApplyBatchOverrides(request)
+
+	if err := algo.ValidateTranslationBatch(request, algo.ValidateInterTransRequest); err != nil {
+		return err
+	}
+
+	//Edges are processed concurrently but a gRPC stream does not support concurrent sends, so a single goroutine
+	//sends the events. The buffer keeps a slow client from stalling the translations
+	events := make(chan *common.TranslationEvent, streamEventBuffer)
+	sent := make(chan error, 1)
+
+	go func() {
+		var sendErr error
+
+		//Once the client is gone there is no point in sending more events, but they are still drained
+		for event := range events {
+			if sendErr == nil {
+				sendErr = stream.Send(event)
+			}
+		}
+
+		sent <- sendErr
+	}()
+
+	send := func(event *common.TranslationEvent) {
+		events <- event
+	}
+
+	listener := &algo.BatchListener{
+		OnEdgeStatusChange: func(edge *common.ResponseTranslationEdge) {
+			send(&common.TranslationEvent{RequestId: request.Id, Edge: edge})
+		},
+		OnTranslationResponse: func(response *common.TranslationResponse) {
+			send(&common.TranslationEvent{RequestId: request.Id, TranslationResponse: response})
+		},
+	}
+
+	algo.InterTrans(stream.Context(), request, listener)
+	close(events)
+
+	return <-sent
 }
 
 func (m *TranslationServer) BatchTranslateCAK(ctx context.Context, request *common.BatchTranslationRequest) (*common.BatchTranslationResponse, error) {
-	return algo.DirectCAK(request), nil
+	if err := algo.CheckDirectCAKConfig(); err != nil {
+		return nil, err
+	}
+
+	common.ApplyBatchOverrides(request)
+
+	if err := algo.ValidateTranslationBatch(request, algo.ValidateDirectCAKRequest); err != nil {
+		return nil, err
+	}
+
+	return algo.DirectCAK(ctx, request), nil
+}
+
+func (m *TranslationServer) BatchPanEtAlTranslate(ctx context.Context, request *common.BatchTranslationRequest) (*common.BatchTranslationResponse, error) {
+	if err := algo.CheckPanEtAlConfig(); err != nil {
+		return nil, err
+	}
+
+	common.ApplyBatchOverrides(request)
+
+	if err := algo.ValidateTranslationBatch(request, algo.ValidateTranslationRequest); err != nil {
+		return nil, err
+	}
+
+	return algo.PanEtAl(ctx, request), nil
 }
 
 // FIXME: This assumes that each intermediate edge is a single translation. This is not always the case.
 func (m *TranslationServer) BatchRunVerification(ctx context.Context, request *common.BatchVerificationRequest) (*common.BatchVerificationResponse, error) {
-	return algo.BatchRunVerification(request), nil
+	if err := algo.CheckVerificationConfig(); err != nil {
+		return nil, err
+	}
+
+	if err := algo.ValidateVerificationBatch(request); err != nil {
+		return nil, err
+	}
+
+	return algo.BatchRunVerification(ctx, request), nil
+}
+
+func (m *TranslationServer) GetLanguageStatistics(ctx context.Context, request *common.LanguageStatisticsRequest) (*common.LanguageStatisticsResponse, error) {
+	return algo.GetLanguageStatistics(request)
+}
+
+func (m *JobServer) SubmitBatch(ctx context.Context, request *common.BatchTranslationRequest) (*common.JobStatus, error) {
+	return algo.SubmitJob(request)
+}
+
+func (m *JobServer) GetJobStatus(ctx context.Context, request *common.JobRequest) (*common.JobStatus, error) {
+	return algo.GetJobStatus(request.JobId)
+}
+
+func (m *JobServer) GetJobResult(ctx context.Context, request *common.JobRequest) (*common.BatchTranslationResponse, error) {
+	return algo.GetJobResult(request.JobId)
+}
+
+func (m *JobServer) CancelJob(ctx context.Context, request *common.JobRequest) (*common.JobStatus, error) {
+	return algo.CancelJob(request.JobId)
 }
 
 func (m *InfrastructureServer) LaunchInferenceEndpoint(ctx context.Context, request *common.StartEndpointRequest) (*common.LaunchResponse, error) {
@@ -96,8 +205,10 @@ func main() {
 	)
 
 	translationServer := &TranslationServer{}
+	jobServer := &JobServer{}
 	infrastructureServer := &InfrastructureServer{}
 	common.RegisterTranslationServiceServer(s, translationServer)
+	common.RegisterJobServiceServer(s, jobServer)
 	common.RegisterInfrastructureServiceServer(s, infrastructureServer)
 
 	if common.ConfigStore.ComputeEfficientMode {
@@ -128,6 +239,10 @@ func main() {
 	common.StoreDatabase(db)
 	defer db.Close()
 
+	algo.RecoverInterruptedJobs()
+
+	algo.BackfillStatistics()
+
 	numCPU := runtime.NumCPU()
 	fmt.Printf("Info: Goroutines scheduled across %d CPUs\n", numCPU)
 